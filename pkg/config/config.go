@@ -1,10 +1,15 @@
 // Package config provides unified configuration management for EggyByte services.
-// It supports environment variable loading, Kubernetes ConfigMap watching,
+// It supports environment variable and layered file/flag loading, Kubernetes ConfigMap watching,
 // and thread-safe global configuration access with type-safe structures.
 package config
 
 import (
+	"fmt"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
 )
 
 // Config holds common configuration for EggyByte services.
@@ -54,13 +59,14 @@ type Config struct {
 	LogLevel string `envconfig:"LOG_LEVEL" default:"info"`
 
 	// LogFormat specifies the log output format.
-	// Valid values: json, console
+	// Valid values: json, console, colorful, simple
 	LogFormat string `envconfig:"LOG_FORMAT" default:"json"`
 
 	// DatabaseDSN is the Data Source Name for database connection.
 	// Format: "username:password@tcp(host:port)/dbname?charset=utf8mb4&parseTime=True"
 	// Empty value means database is not used by this service.
-	DatabaseDSN string `envconfig:"DATABASE_DSN"`
+	// Redacted from configuration dumps since it usually contains credentials.
+	DatabaseDSN string `envconfig:"DATABASE_DSN" secret:"true"`
 
 	// DatabaseMaxOpenConns sets the maximum number of open database connections.
 	DatabaseMaxOpenConns int `envconfig:"DATABASE_MAX_OPEN_CONNS" default:"100"`
@@ -93,13 +99,47 @@ type Config struct {
 
 	// K8sConfigMapName is the name of the ConfigMap to watch for config updates.
 	K8sConfigMapName string `envconfig:"K8S_CONFIGMAP_NAME"`
+
+	// K8sKubeconfig is the path to a kubeconfig file used to reach the Kubernetes API.
+	// When empty, the in-cluster service account configuration is used.
+	// Intended for local development outside a cluster.
+	K8sKubeconfig string `envconfig:"K8S_KUBECONFIG"`
+
+	// K8sSecretName is the name of a Secret to watch for config updates.
+	// Secret keys map onto config fields like ConfigMap keys (e.g., DATABASE_DSN).
+	// Optional; requires 'get', 'list' and 'watch' permissions on Secrets.
+	K8sSecretName string `envconfig:"K8S_SECRET_NAME"`
+
+	// K8sResyncPeriod is how often the ConfigMap informer replays its cache.
+	// Replays with unchanged data do not trigger configuration updates.
+	// Set to 0 to disable periodic resync.
+	K8sResyncPeriod time.Duration `envconfig:"K8S_RESYNC_PERIOD" default:"30s"`
+
+	// K8sCacheSyncTimeout bounds the wait for the initial ConfigMap sync at startup.
+	K8sCacheSyncTimeout time.Duration `envconfig:"K8S_CACHE_SYNC_TIMEOUT" default:"30s"`
+
+	// EnableConfigz exposes the effective configuration at /configz on the
+	// health check port (or the metrics port if health checks are disabled).
+	// Fields tagged `secret:"true"` are redacted.
+	EnableConfigz bool `envconfig:"ENABLE_CONFIGZ" default:"false"`
+
+	// ShutdownDrainPeriod is how long to keep serving after SIGTERM before
+	// stopping services. /readyz fails during this period so load balancers
+	// stop sending new traffic first. Set to 0 to stop immediately.
+	ShutdownDrainPeriod time.Duration `envconfig:"SHUTDOWN_DRAIN_PERIOD" default:"0s"`
 }
 
 var (
 	// globalConfig holds the singleton configuration instance.
 	globalConfig *Config
 
-	// configMutex protects concurrent access to globalConfig.
+	// globalSources records where each field of globalConfig came from.
+	globalSources map[string]ValueSource
+
+	// lastReload is when globalConfig was last replaced.
+	lastReload time.Time
+
+	// configMutex protects concurrent access to globalConfig, globalSources and lastReload.
 	configMutex sync.RWMutex
 )
 
@@ -123,6 +163,8 @@ func Get() *Config {
 
 // Set updates the global configuration with a new instance.
 // This method is thread-safe and typically called during service initialization.
+// Subscribers registered via Subscribe are notified after the swap.
+// Recorded value sources are kept; use SetSources to replace them.
 //
 // Parameters:
 //   - cfg: The new configuration to set globally.
@@ -132,31 +174,211 @@ func Get() *Config {
 //	newConfig := &config.Config{ServiceName: "user-service"}
 //	config.Set(newConfig)
 func Set(cfg *Config) {
+	notifyMutex.Lock()
+	defer notifyMutex.Unlock()
+
 	configMutex.Lock()
-	defer configMutex.Unlock()
+	old := globalConfig
 	globalConfig = cfg
+	lastReload = time.Now()
+	configMutex.Unlock()
+
+	notifySubscribers(old, cfg)
 }
 
 // Update applies partial configuration updates from a map.
 // This method is used by Kubernetes ConfigMap watchers to dynamically
 // update configuration without restarting the service.
+// Changed fields are recorded with SourceUpdate; use UpdateFrom to
+// attribute them to a specific source.
+//
+// Keys are matched against the `envconfig` tag names of Config fields.
+// Matching is case-insensitive and treats '-' as '_', so "LOG_LEVEL",
+// "log_level" and "log-level" all update LogLevel. Keys that do not
+// correspond to any field are ignored.
+//
+// Updates are applied to a copy of the current configuration, converted to
+// the field types and validated with ValidateConfig. Only when every value
+// converts and the resulting configuration is valid is the copy swapped in
+// as the new global configuration. Otherwise the update is rejected as a
+// whole and the current configuration is left untouched. Subscribers
+// registered via Subscribe are notified after a successful swap.
 //
 // Parameters:
 //   - updates: Map of configuration keys to new values.
 //
+// Returns:
+//   - error: Returns error if the global config is not initialized, a value
+//     cannot be converted, or the updated configuration fails validation.
+//
 // Thread Safety: This method is thread-safe for concurrent updates.
 //
-// Note: Only string-based configuration fields can be updated this way.
-// Complex types require service restart.
-func Update(updates map[string]string) {
+// Example:
+//
+//	if err := config.Update(map[string]string{"LOG_LEVEL": "debug"}); err != nil {
+//	    log.Warn("Config update rejected", log.Field{Key: "error", Value: err})
+//	}
+func Update(updates map[string]string) error {
+	return UpdateFrom(ValueSource{Source: SourceUpdate}, updates)
+}
+
+// UpdateFrom behaves like Update and records source as the origin of every
+// field the update changed, so that Sources and /configz can report it.
+//
+// Parameters:
+//   - source: Where the updates came from; Key is filled in per field
+//   - updates: Map of configuration keys to new values.
+//
+// Returns:
+//   - error: Same conditions as Update
+//
+// Example:
+//
+//	err := config.UpdateFrom(config.ValueSource{Source: config.SourceConfigMap, Origin: "app-config"}, data)
+func UpdateFrom(source ValueSource, updates map[string]string) error {
+	notifyMutex.Lock()
+	defer notifyMutex.Unlock()
+
+	old, next, err := swapUpdated(source, updates)
+	if err != nil {
+		return err
+	}
+
+	notifySubscribers(old, next)
+	return nil
+}
+
+// SetSources replaces the recorded origin of each global configuration field,
+// typically with Loader.Sources after loading the configuration passed to Set.
+//
+// Parameters:
+//   - sources: Value sources keyed by Go field name
+//
+// Example:
+//
+//	if err := loader.Load(cfg); err != nil {
+//	    return err
+//	}
+//	config.Set(cfg)
+//	config.SetSources(loader.Sources())
+func SetSources(sources map[string]ValueSource) {
+	configMutex.Lock()
+	defer configMutex.Unlock()
+
+	globalSources = make(map[string]ValueSource, len(sources))
+	for k, v := range sources {
+		globalSources[k] = v
+	}
+}
+
+// Sources returns the recorded origin of each global configuration field,
+// keyed by Go field name. Fields without a recorded source are omitted.
+//
+// Returns:
+//   - map[string]ValueSource: A copy of the recorded sources
+func Sources() map[string]ValueSource {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+
+	out := make(map[string]ValueSource, len(globalSources))
+	for k, v := range globalSources {
+		out[k] = v
+	}
+	return out
+}
+
+// LastReload returns when the global configuration was last replaced by
+// Set, Update or UpdateFrom. It is the zero time before the first Set.
+//
+// Returns:
+//   - time.Time: Time of the last configuration change
+func LastReload() time.Time {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+	return lastReload
+}
+
+// swapUpdated builds, validates and installs the updated configuration.
+// Returns the previous and new configuration; both are equal when nothing changed.
+func swapUpdated(source ValueSource, updates map[string]string) (old, next *Config, err error) {
 	configMutex.Lock()
 	defer configMutex.Unlock()
 
 	if globalConfig == nil {
-		return
+		return nil, nil, fmt.Errorf("configuration not initialized")
+	}
+
+	candidate := *globalConfig
+	changed, err := applyUpdates(&candidate, updates)
+	if err != nil {
+		log.Error("Rejected configuration update",
+			log.Field{Key: "error", Value: err})
+		return nil, nil, fmt.Errorf("rejected configuration update: %w", err)
+	}
+
+	if len(changed) == 0 {
+		return globalConfig, globalConfig, nil
+	}
+
+	keys := make([]string, len(changed))
+	for i, f := range changed {
+		keys[i] = f.Key
+	}
+
+	if err := ValidateConfig(&candidate); err != nil {
+		log.Error("Rejected invalid configuration update",
+			log.Field{Key: "keys", Value: keys},
+			log.Field{Key: "error", Value: err})
+		return nil, nil, fmt.Errorf("rejected configuration update: %w", err)
+	}
+
+	old = globalConfig
+	globalConfig = &candidate
+	lastReload = time.Now()
+
+	if globalSources == nil {
+		globalSources = make(map[string]ValueSource)
+	}
+	for _, f := range changed {
+		src := source
+		src.Key = f.Key
+		globalSources[f.Name] = src
+	}
+
+	log.Info("Configuration updated",
+		log.Field{Key: "keys", Value: keys},
+		log.Field{Key: "source", Value: string(source.Source)})
+	return old, globalConfig, nil
+}
+
+// applyUpdates converts and assigns updates onto cfg.
+// Returns the fields whose values actually changed, in field order.
+func applyUpdates(cfg *Config, updates map[string]string) ([]fieldInfo, error) {
+	normalized := make(map[string]string, len(updates))
+	for k, v := range updates {
+		normalized[normalizeKey(k)] = v
+	}
+
+	fields, err := collectFields(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []fieldInfo
+	for _, f := range fields {
+		raw, ok := normalized[normalizeKey(f.Key)]
+		if !ok {
+			continue
+		}
+
+		before := f.Value.Interface()
+		if err := setFieldFromString(f.Value, strings.TrimSpace(raw)); err != nil {
+			return nil, fmt.Errorf("field %s (%s): %w", f.Name, f.Key, err)
+		}
+		if f.Value.Interface() != before {
+			changed = append(changed, f)
+		}
 	}
 
-	// Apply updates to mutable fields
-	// TODO: Implement field-by-field update logic
-	// This will be expanded when K8s watcher is implemented
+	return changed, nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestGet_WhenNotInitialized tests that Get returns nil before configuration is set.
@@ -130,29 +131,134 @@ func TestUpdate_WithNilConfig(t *testing.T) {
 	}, "Update should handle nil config without panicking")
 }
 
-// TestUpdate_WithValidConfig tests that Update accepts valid update maps.
-// This is an isolated method test with no external dependencies.
-// Note: The Update function is currently a TODO, so this tests the basic structure.
+// TestUpdate_WithValidConfig tests that Update applies valid updates.
+// This verifies keys are matched against envconfig tag names.
 func TestUpdate_WithValidConfig(t *testing.T) {
-	cfg := &Config{
-		ServiceName: "test-service",
-		LogLevel:    "info",
-	}
-	Set(cfg)
+	Set(newValidTestConfig())
 
 	updates := map[string]string{
 		"log_level": "debug",
 	}
 
-	// Should not panic
-	assert.NotPanics(t, func() {
-		Update(updates)
-	}, "Update should accept valid updates without panicking")
+	err := Update(updates)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "debug", Get().LogLevel)
 
 	// Cleanup
 	Set(nil)
 }
 
+// TestUpdate_TypeConversion tests that Update converts values to field types.
+// This verifies integer, boolean and string fields are all updatable.
+func TestUpdate_TypeConversion(t *testing.T) {
+	Set(newValidTestConfig())
+
+	err := Update(map[string]string{
+		"METRICS_PORT":         "9100",
+		"ENABLE_BUSINESS_GRPC": "false",
+		"LOG-FORMAT":           "console",
+	})
+
+	require.NoError(t, err)
+	cfg := Get()
+	assert.Equal(t, 9100, cfg.MetricsPort)
+	assert.False(t, cfg.EnableBusinessGRPC)
+	assert.Equal(t, "console", cfg.LogFormat)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestUpdate_ReplacesPointer tests that Update swaps in a new instance.
+// This verifies readers holding the previous pointer see a consistent snapshot.
+func TestUpdate_ReplacesPointer(t *testing.T) {
+	original := newValidTestConfig()
+	Set(original)
+
+	err := Update(map[string]string{"LOG_LEVEL": "warn"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "info", original.LogLevel, "Previous snapshot must not be mutated")
+	assert.Equal(t, "warn", Get().LogLevel)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestUpdate_InvalidValueRejected tests that conversion failures reject the update.
+// This verifies no field is applied when any value is malformed.
+func TestUpdate_InvalidValueRejected(t *testing.T) {
+	Set(newValidTestConfig())
+
+	err := Update(map[string]string{
+		"LOG_LEVEL":    "debug",
+		"METRICS_PORT": "not-a-number",
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "METRICS_PORT")
+	assert.Equal(t, "info", Get().LogLevel, "Update must not be applied halfway")
+	assert.Equal(t, 9091, Get().MetricsPort)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestUpdate_ValidationFailureRejected tests that invalid configurations are rejected.
+// This verifies ValidateConfig runs before the new configuration is swapped in.
+func TestUpdate_ValidationFailureRejected(t *testing.T) {
+	Set(newValidTestConfig())
+
+	err := Update(map[string]string{
+		"LOG_LEVEL":    "debug",
+		"METRICS_PORT": "8080", // Conflicts with business HTTP port
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "info", Get().LogLevel)
+	assert.Equal(t, 9091, Get().MetricsPort)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestUpdate_UnknownKeysIgnored tests that unrelated keys do not affect the config.
+// This verifies ConfigMaps may carry keys not mapped to Config fields.
+func TestUpdate_UnknownKeysIgnored(t *testing.T) {
+	original := newValidTestConfig()
+	Set(original)
+
+	err := Update(map[string]string{"SOME_OTHER_KEY": "value"})
+
+	assert.NoError(t, err)
+	assert.Same(t, original, Get(), "No-op update should keep the current instance")
+
+	// Cleanup
+	Set(nil)
+}
+
+// newValidTestConfig returns a configuration that passes ValidateConfig.
+// Helper function for update tests.
+func newValidTestConfig() *Config {
+	return &Config{
+		ServiceName:          "test-service",
+		Environment:          "test",
+		BusinessHTTPPort:     8080,
+		BusinessGRPCPort:     9090,
+		HealthCheckPort:      8081,
+		MetricsPort:          9091,
+		LogLevel:             "info",
+		LogFormat:            "json",
+		DatabaseMaxOpenConns: 100,
+		DatabaseMaxIdleConns: 10,
+		EnableBusinessHTTP:   true,
+		EnableBusinessGRPC:   true,
+		EnableHealthCheck:    true,
+		EnableMetrics:        true,
+	}
+}
+
 // TestConfig_DefaultValues tests the default values in Config struct.
 // This verifies the struct tag defaults are correctly defined.
 func TestConfig_DefaultValues(t *testing.T) {
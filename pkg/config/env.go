@@ -3,6 +3,7 @@ package config
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -33,6 +34,9 @@ import (
 //	if err := config.ReadFromEnv(&cfg); err != nil {
 //	    log.Fatal("Failed to load config:", err)
 //	}
+//
+// ReadFromEnv does not validate values; call Validate afterwards to apply
+// `validate` tag rules and Validator implementations.
 func ReadFromEnv(cfg interface{}) error {
 	if err := envconfig.Process("", cfg); err != nil {
 		return fmt.Errorf("failed to process environment configuration: %w", err)
@@ -64,73 +68,112 @@ func MustReadFromEnv(cfg interface{}) {
 
 // ValidateConfig performs additional validation on loaded configuration.
 // This checks for logical consistency beyond what struct tags can enforce.
+// Rules only apply to components that are enabled, and every rule is
+// checked so that all violations are reported together. Messages name the
+// field and the rule but never the rejected value, so they are safe to log
+// when the value came from a Secret.
 //
 // Parameters:
 //   - cfg: Configuration instance to validate
 //
 // Returns:
-//   - error: Returns error if validation fails with descriptive message
+//   - error: ValidationErrors of *FieldError, one per violation, or nil if valid
 //
 // Validation rules:
 //   - ServiceName must not be empty
-//   - Port must be in valid range (1-65535)
-//   - MetricsPort must be different from Port
+//   - Ports of enabled servers must be in valid range (1-65535) and distinct;
+//     ports of disabled servers are ignored
 //   - LogLevel must be one of: debug, info, warn, error, fatal
+//   - LogFormat must be one of: json, console, colorful, simple
+//   - Database pool sizes must not be negative, and DatabaseMaxIdleConns
+//     must not exceed DatabaseMaxOpenConns unless the latter is 0 (unlimited)
+//   - K8s resync period and cache sync timeout must not be negative
 //   - If K8s watching enabled, namespace and configmap name required
+//   - ShutdownDrainPeriod must not be negative
 func ValidateConfig(cfg *Config) error {
-	if err := validateServiceName(cfg.ServiceName); err != nil {
-		return err
-	}
+	var errs ValidationErrors
 
-	if err := validatePorts(cfg); err != nil {
-		return err
-	}
+	errs.add(validateServiceName(cfg.ServiceName))
+	errs.add(validatePorts(cfg))
+	errs.add(validateLogLevel(cfg.LogLevel))
+	errs.add(validateLogFormat(cfg.LogFormat))
+	errs.add(validateDatabasePool(cfg))
+	errs.add(validateK8sConfig(cfg))
 
-	if err := validateLogLevel(cfg.LogLevel); err != nil {
-		return err
+	if cfg.ShutdownDrainPeriod < 0 {
+		errs.add(configFieldError("ShutdownDrainPeriod", "min",
+			"shutdown drain period cannot be negative"))
 	}
 
-	if err := validateK8sConfig(cfg); err != nil {
-		return err
-	}
+	return errs.ErrOrNil()
+}
 
-	return nil
+// Validate implements Validator by applying ValidateConfig.
+// Service configs embedding Config inherit this method.
+//
+// Returns:
+//   - error: ValidationErrors listing every violation, or nil if valid
+func (c *Config) Validate() error {
+	return ValidateConfig(c)
+}
+
+// configFieldError builds a FieldError for the named Config field.
+func configFieldError(field, rule, format string, args ...interface{}) *FieldError {
+	key := field
+	if sf, ok := reflect.TypeOf(Config{}).FieldByName(field); ok {
+		key = sf.Tag.Get("envconfig")
+	}
+	return &FieldError{Field: field, Key: key, Rule: rule, Message: fmt.Sprintf(format, args...)}
 }
 
 // validateServiceName validates the service name
 func validateServiceName(serviceName string) error {
 	if serviceName == "" {
-		return fmt.Errorf("service name cannot be empty")
+		return configFieldError("ServiceName", "required", "service name cannot be empty")
 	}
 	return nil
 }
 
-// validatePorts validates all port configurations
+// validatePorts validates the ports of enabled servers
 func validatePorts(cfg *Config) error {
-	ports := map[string]int{
-		"business HTTP": cfg.BusinessHTTPPort,
-		"business gRPC": cfg.BusinessGRPCPort,
-		"health check":  cfg.HealthCheckPort,
-		"metrics":       cfg.MetricsPort,
+	// Ordered so that error messages are deterministic
+	ports := []struct {
+		name    string
+		field   string
+		port    int
+		enabled bool
+	}{
+		{"business HTTP", "BusinessHTTPPort", cfg.BusinessHTTPPort, cfg.EnableBusinessHTTP},
+		{"business gRPC", "BusinessGRPCPort", cfg.BusinessGRPCPort, cfg.EnableBusinessGRPC},
+		{"health check", "HealthCheckPort", cfg.HealthCheckPort, cfg.EnableHealthCheck},
+		{"metrics", "MetricsPort", cfg.MetricsPort, cfg.EnableMetrics},
 	}
 
-	// Validate port ranges
-	for name, port := range ports {
-		if port < 1 || port > 65535 {
-			return fmt.Errorf("%s port must be between 1 and 65535, got: %d", name, port)
+	var errs ValidationErrors
+	portMap := make(map[int]string)
+
+	for _, p := range ports {
+		if !p.enabled {
+			continue
 		}
-	}
 
-	// Validate port uniqueness
-	portMap := make(map[int]string)
-	for name, port := range ports {
-		if existing, exists := portMap[port]; exists {
-			return fmt.Errorf("%s and %s ports cannot be the same: %d", name, existing, port)
+		// Validate port range
+		if p.port < 1 || p.port > 65535 {
+			errs.add(configFieldError(p.field, "port",
+				"%s port must be between 1 and 65535", p.name))
+			continue
 		}
-		portMap[port] = name
+
+		// Validate port uniqueness
+		if existing, exists := portMap[p.port]; exists {
+			errs.add(configFieldError(p.field, "unique",
+				"%s and %s ports cannot be the same", existing, p.name))
+			continue
+		}
+		portMap[p.port] = p.name
 	}
 
-	return nil
+	return errs.ErrOrNil()
 }
 
 // validateLogLevel validates the log level
@@ -143,24 +186,73 @@ func validateLogLevel(logLevel string) error {
 		"fatal": true,
 	}
 	if !validLogLevels[logLevel] {
-		return fmt.Errorf("invalid log level: %s (must be debug, info, warn, error, or fatal)", logLevel)
+		return configFieldError("LogLevel", "oneof",
+			"invalid log level (must be debug, info, warn, error, or fatal)")
 	}
 	return nil
 }
 
+// validateLogFormat validates the log format against the formats log.Init accepts
+func validateLogFormat(logFormat string) error {
+	switch logFormat {
+	case "json", "console", "colorful", "simple":
+		return nil
+	default:
+		return configFieldError("LogFormat", "oneof",
+			"invalid log format (must be json, console, colorful, or simple)")
+	}
+}
+
+// validateDatabasePool validates the database connection pool sizes
+func validateDatabasePool(cfg *Config) error {
+	var errs ValidationErrors
+
+	if cfg.DatabaseMaxOpenConns < 0 {
+		errs.add(configFieldError("DatabaseMaxOpenConns", "min",
+			"database max open connections cannot be negative"))
+	}
+
+	if cfg.DatabaseMaxIdleConns < 0 {
+		errs.add(configFieldError("DatabaseMaxIdleConns", "min",
+			"database max idle connections cannot be negative"))
+	}
+
+	// A max open value of 0 means unlimited
+	if cfg.DatabaseMaxOpenConns > 0 && cfg.DatabaseMaxIdleConns > cfg.DatabaseMaxOpenConns {
+		errs.add(configFieldError("DatabaseMaxIdleConns", "max",
+			"database max idle connections cannot exceed max open connections"))
+	}
+
+	return errs.ErrOrNil()
+}
+
 // validateK8sConfig validates Kubernetes configuration
 func validateK8sConfig(cfg *Config) error {
+	var errs ValidationErrors
+
+	if cfg.K8sResyncPeriod < 0 {
+		errs.add(configFieldError("K8sResyncPeriod", "min",
+			"kubernetes resync period cannot be negative"))
+	}
+
+	if cfg.K8sCacheSyncTimeout < 0 {
+		errs.add(configFieldError("K8sCacheSyncTimeout", "min",
+			"kubernetes cache sync timeout cannot be negative"))
+	}
+
 	if !cfg.EnableK8sConfigWatch {
-		return nil
+		return errs.ErrOrNil()
 	}
 
 	if cfg.K8sNamespace == "" {
-		return fmt.Errorf("kubernetes namespace required when config watch enabled")
+		errs.add(configFieldError("K8sNamespace", "required",
+			"kubernetes namespace required when config watch enabled"))
 	}
 
 	if cfg.K8sConfigMapName == "" {
-		return fmt.Errorf("kubernetes configmap name required when config watch enabled")
+		errs.add(configFieldError("K8sConfigMapName", "required",
+			"kubernetes configmap name required when config watch enabled"))
 	}
 
-	return nil
+	return errs.ErrOrNil()
 }
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -120,6 +122,7 @@ func TestValidateConfig_ValidConfig(t *testing.T) {
 		HealthCheckPort:  8081,
 		MetricsPort:      9091,
 		LogLevel:         "info",
+		LogFormat:        "json",
 	}
 
 	err := ValidateConfig(cfg)
@@ -160,12 +163,14 @@ func TestValidateConfig_InvalidPort(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := &Config{
-				ServiceName:      "test-service",
-				BusinessHTTPPort: tt.port,
-				BusinessGRPCPort: 9090,
-				HealthCheckPort:  8081,
-				MetricsPort:      9091,
-				LogLevel:         "info",
+				ServiceName:        "test-service",
+				BusinessHTTPPort:   tt.port,
+				EnableBusinessHTTP: true,
+				BusinessGRPCPort:   9090,
+				HealthCheckPort:    8081,
+				MetricsPort:        9091,
+				LogLevel:           "info",
+				LogFormat:          "json",
 			}
 
 			err := ValidateConfig(cfg)
@@ -185,7 +190,9 @@ func TestValidateConfig_InvalidMetricsPort(t *testing.T) {
 		BusinessGRPCPort: 9090,
 		HealthCheckPort:  8081,
 		MetricsPort:      0,
+		EnableMetrics:    true,
 		LogLevel:         "info",
+		LogFormat:        "json",
 	}
 
 	err := ValidateConfig(cfg)
@@ -198,12 +205,15 @@ func TestValidateConfig_InvalidMetricsPort(t *testing.T) {
 // This verifies the port conflict detection logic.
 func TestValidateConfig_SamePort(t *testing.T) {
 	cfg := &Config{
-		ServiceName:      "test-service",
-		BusinessHTTPPort: 8080,
-		BusinessGRPCPort: 9090,
-		HealthCheckPort:  8081,
-		MetricsPort:      8080, // Same as business port
-		LogLevel:         "info",
+		ServiceName:        "test-service",
+		BusinessHTTPPort:   8080,
+		BusinessGRPCPort:   9090,
+		HealthCheckPort:    8081,
+		MetricsPort:        8080, // Same as business port
+		EnableBusinessHTTP: true,
+		EnableMetrics:      true,
+		LogLevel:           "info",
+		LogFormat:          "json",
 	}
 
 	err := ValidateConfig(cfg)
@@ -222,6 +232,7 @@ func TestValidateConfig_InvalidLogLevel(t *testing.T) {
 		HealthCheckPort:  8081,
 		MetricsPort:      9091,
 		LogLevel:         "invalid",
+		LogFormat:        "json",
 	}
 
 	err := ValidateConfig(cfg)
@@ -244,6 +255,7 @@ func TestValidateConfig_ValidLogLevels(t *testing.T) {
 				HealthCheckPort:  8081,
 				MetricsPort:      9091,
 				LogLevel:         level,
+				LogFormat:        "json",
 			}
 
 			err := ValidateConfig(cfg)
@@ -263,6 +275,7 @@ func TestValidateConfig_K8sWatch_MissingNamespace(t *testing.T) {
 		HealthCheckPort:      8081,
 		MetricsPort:          9091,
 		LogLevel:             "info",
+		LogFormat:            "json",
 		EnableK8sConfigWatch: true,
 		K8sNamespace:         "", // Missing
 		K8sConfigMapName:     "config",
@@ -284,6 +297,7 @@ func TestValidateConfig_K8sWatch_MissingConfigMapName(t *testing.T) {
 		HealthCheckPort:      8081,
 		MetricsPort:          9091,
 		LogLevel:             "info",
+		LogFormat:            "json",
 		EnableK8sConfigWatch: true,
 		K8sNamespace:         "default",
 		K8sConfigMapName:     "", // Missing
@@ -305,6 +319,7 @@ func TestValidateConfig_K8sWatch_ValidConfig(t *testing.T) {
 		HealthCheckPort:      8081,
 		MetricsPort:          9091,
 		LogLevel:             "info",
+		LogFormat:            "json",
 		EnableK8sConfigWatch: true,
 		K8sNamespace:         "default",
 		K8sConfigMapName:     "app-config",
@@ -315,11 +330,127 @@ func TestValidateConfig_K8sWatch_ValidConfig(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestValidateConfig_DisabledServerPorts tests that disabled servers are ignored.
+// This verifies port range and conflict checks only apply to enabled components.
+func TestValidateConfig_DisabledServerPorts(t *testing.T) {
+	cfg := &Config{
+		ServiceName:        "test-service",
+		BusinessHTTPPort:   8080,
+		BusinessGRPCPort:   8080, // Conflicts, but gRPC is disabled
+		HealthCheckPort:    8081,
+		MetricsPort:        0, // Invalid, but metrics are disabled
+		LogLevel:           "info",
+		LogFormat:          "json",
+		EnableBusinessHTTP: true,
+		EnableHealthCheck:  true,
+	}
+
+	assert.NoError(t, ValidateConfig(cfg))
+
+	cfg.EnableBusinessGRPC = true
+	err := ValidateConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "business HTTP and business gRPC ports cannot be the same")
+}
+
+// TestValidateConfig_LogFormat tests log format validation.
+// This verifies only formats accepted by log.Init pass.
+func TestValidateConfig_LogFormat(t *testing.T) {
+	for _, format := range []string{"json", "console", "colorful", "simple"} {
+		cfg := &Config{ServiceName: "test-service", LogLevel: "info", LogFormat: format}
+		assert.NoError(t, ValidateConfig(cfg), "Log format '%s' should be valid", format)
+	}
+
+	cfg := &Config{ServiceName: "test-service", LogLevel: "info", LogFormat: "xml"}
+	err := ValidateConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "LOG_FORMAT: invalid log format")
+}
+
+// TestValidateConfig_ShutdownDrainPeriod tests drain period validation.
+// This verifies a negative drain period is rejected.
+func TestValidateConfig_ShutdownDrainPeriod(t *testing.T) {
+	cfg := &Config{ServiceName: "test-service", LogLevel: "info", LogFormat: "json", ShutdownDrainPeriod: 5 * time.Second}
+	assert.NoError(t, ValidateConfig(cfg))
+
+	cfg.ShutdownDrainPeriod = -time.Second
+	err := ValidateConfig(cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "SHUTDOWN_DRAIN_PERIOD: shutdown drain period cannot be negative")
+}
+
+// TestValidateConfig_DatabasePool tests database pool size validation.
+// This verifies negative sizes and idle connections above the open limit are rejected.
+func TestValidateConfig_DatabasePool(t *testing.T) {
+	tests := []struct {
+		name    string
+		open    int
+		idle    int
+		wantErr string
+	}{
+		{"valid", 100, 10, ""},
+		{"idle equals open", 10, 10, ""},
+		{"unlimited open", 0, 50, ""},
+		{"idle exceeds open", 10, 20, "max idle connections cannot exceed max open connections"},
+		{"negative open", -1, 0, "max open connections cannot be negative"},
+		{"negative idle", 10, -1, "max idle connections cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ServiceName:          "test-service",
+				LogLevel:             "info",
+				LogFormat:            "json",
+				DatabaseMaxOpenConns: tt.open,
+				DatabaseMaxIdleConns: tt.idle,
+			}
+
+			err := ValidateConfig(cfg)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// TestValidateConfig_FieldErrors tests the structure of reported violations.
+// This verifies every violation identifies its field and environment key.
+func TestValidateConfig_FieldErrors(t *testing.T) {
+	cfg := &Config{
+		ServiceName:         "test-service",
+		LogLevel:            "info",
+		LogFormat:           "json",
+		K8sResyncPeriod:     -time.Second,
+		K8sCacheSyncTimeout: -time.Second,
+	}
+
+	err := ValidateConfig(cfg)
+
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+	require.Len(t, verrs, 2)
+
+	var fe *FieldError
+	require.True(t, errors.As(verrs[0], &fe))
+	assert.Equal(t, "K8sResyncPeriod", fe.Field)
+	assert.Equal(t, "K8S_RESYNC_PERIOD", fe.Key)
+	assert.Equal(t, "min", fe.Rule)
+}
+
 // cleanupEnv removes all test environment variables.
 // Helper function for test isolation.
 func cleanupEnv() {
 	vars := []string{
 		"SERVICE_NAME", "ENVIRONMENT", "PORT", "METRICS_PORT",
+		"BUSINESS_HTTP_PORT", "BUSINESS_GRPC_PORT", "HEALTH_CHECK_PORT",
 		"LOG_LEVEL", "LOG_FORMAT", "DATABASE_DSN",
 		"DATABASE_MAX_OPEN_CONNS", "DATABASE_MAX_IDLE_CONNS",
 		"ENABLE_K8S_CONFIG_WATCH", "K8S_NAMESPACE", "K8S_CONFIGMAP_NAME",
@@ -0,0 +1,157 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldInfo describes a single configurable struct field.
+// It is produced by walking a configuration struct and is shared by the
+// ConfigMap update path and the other configuration sources.
+type fieldInfo struct {
+	// Name is the Go field name (e.g., "LogLevel").
+	Name string
+
+	// Key is the envconfig key (e.g., "LOG_LEVEL").
+	Key string
+
+	// Field is the reflected struct field definition.
+	Field reflect.StructField
+
+	// Value is the settable field value.
+	Value reflect.Value
+}
+
+// collectFields walks the struct pointed to by cfg and returns every leaf
+// field together with its envconfig key. Anonymous embedded structs are
+// flattened the same way envconfig promotes them, so a service config that
+// embeds Config exposes the same keys as Config itself.
+//
+// Parameters:
+//   - cfg: Pointer to a configuration struct
+//
+// Returns:
+//   - []fieldInfo: Fields in declaration order
+//   - error: Returns error if cfg is not a non-nil struct pointer
+func collectFields(cfg interface{}) ([]fieldInfo, error) {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil, fmt.Errorf("configuration must be a non-nil struct pointer, got %T", cfg)
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("configuration must be a non-nil struct pointer, got %T", cfg)
+	}
+
+	var fields []fieldInfo
+	walkFields(v, &fields)
+	return fields, nil
+}
+
+// walkFields appends the leaf fields of v to fields, descending into
+// anonymous embedded structs.
+func walkFields(v reflect.Value, fields *[]fieldInfo) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+
+		if !sf.IsExported() || sf.Tag.Get("ignored") == "true" {
+			continue
+		}
+
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			walkFields(fv, fields)
+			continue
+		}
+
+		key := sf.Tag.Get("envconfig")
+		if key == "" {
+			key = strings.ToUpper(sf.Name)
+		}
+
+		*fields = append(*fields, fieldInfo{
+			Name:  sf.Name,
+			Key:   key,
+			Field: sf,
+			Value: fv,
+		})
+	}
+}
+
+// normalizeKey folds a configuration key into its canonical form so that
+// "LOG_LEVEL", "log_level" and "log-level" all refer to the same field.
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(key), "-", "_"))
+}
+
+// setFieldFromString converts raw to the field's type and assigns it.
+// Supported kinds are strings, booleans, signed and unsigned integers,
+// floats and time.Duration.
+//
+// Returned errors never include raw, since values may originate from
+// Kubernetes Secrets and end up in logs.
+//
+// Parameters:
+//   - field: Settable reflected field value
+//   - raw: String representation of the new value
+//
+// Returns:
+//   - error: Returns error if the value cannot be converted
+func setFieldFromString(field reflect.Value, raw string) error {
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration value")
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return conversionError("boolean", err)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 0, field.Type().Bits())
+		if err != nil {
+			return conversionError("integer", err)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 0, field.Type().Bits())
+		if err != nil {
+			return conversionError("unsigned integer", err)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return conversionError("float", err)
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// conversionError describes a failed strconv conversion without echoing the
+// input value.
+func conversionError(kind string, err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return fmt.Errorf("invalid %s value: %w", kind, numErr.Err)
+	}
+	return fmt.Errorf("invalid %s value", kind)
+}
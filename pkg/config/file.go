@@ -0,0 +1,115 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// readConfigFile reads a configuration file and flattens it into
+// envconfig-style keys. Nested tables are joined with '_'.
+//
+// Parameters:
+//   - path: Path to a .yaml, .yml, .json or .toml file
+//
+// Returns:
+//   - map[string]string: Flattened keys and their string values
+//   - error: Returns error if the file cannot be read, parsed or has an unsupported format
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var values map[string]string
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		var jsonData []byte
+		jsonData, err = yaml.YAMLToJSON(data)
+		if err == nil {
+			values, err = parseJSON(jsonData)
+		}
+	case ".json":
+		values, err = parseJSON(data)
+	case ".toml":
+		values, err = parseTOML(data)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %q: %s", ext, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// parseJSON decodes a JSON object and flattens it into string values.
+func parseJSON(data []byte) (map[string]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	if err := flattenValues("", doc, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// flattenValues copies scalar values from doc into out, joining nested keys with '_'.
+// Arrays and other non-scalar values are rejected.
+func flattenValues(prefix string, doc map[string]interface{}, out map[string]string) error {
+	for k, v := range doc {
+		key := k
+		if prefix != "" {
+			key = prefix + "_" + k
+		}
+
+		switch val := v.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			if err := flattenValues(key, val, out); err != nil {
+				return err
+			}
+		case string:
+			out[key] = val
+		case json.Number:
+			out[key] = val.String()
+		case int64:
+			out[key] = strconv.FormatInt(val, 10)
+		case float64:
+			out[key] = strconv.FormatFloat(val, 'f', -1, 64)
+		case bool:
+			out[key] = strconv.FormatBool(val)
+		default:
+			return fmt.Errorf("key %s: unsupported value type %T", key, v)
+		}
+	}
+	return nil
+}
+
+// parseTOML decodes a TOML document and flattens it into string values.
+func parseTOML(data []byte) (map[string]string, error) {
+	var doc map[string]interface{}
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	if err := flattenValues("", doc, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
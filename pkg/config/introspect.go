@@ -0,0 +1,119 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// redactedValue replaces secret values in configuration dumps.
+const redactedValue = "<redacted>"
+
+// Handler returns an HTTP handler that dumps the effective global
+// configuration as JSON, together with the source of each value and the
+// time of the last reload.
+//
+// Fields tagged `secret:"true"` and fields last set from a Kubernetes Secret
+// are shown as "<redacted>" when non-empty. Durations are rendered in Go
+// duration syntax (e.g., "30s").
+//
+// Returns:
+//   - http.Handler: Handler responding to GET and HEAD requests
+//
+// Response:
+//
+//	{
+//	  "config": {"ServiceName": "user-service", "DatabaseDSN": "<redacted>", ...},
+//	  "sources": {"MetricsPort": "env: METRICS_PORT", ...},
+//	  "last_reload": "2024-01-01T00:00:00Z",
+//	  "timestamp": "2024-01-01T00:05:00Z"
+//	}
+//
+// Example:
+//
+//	if err := healthService.Handle("/configz", config.Handler()); err != nil {
+//	    return err
+//	}
+func Handler() http.Handler {
+	return http.HandlerFunc(serveConfig)
+}
+
+// serveConfig writes the configuration dump for Handler.
+func serveConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	configMutex.RLock()
+	cfg := globalConfig
+	reloaded := lastReload
+	sources := make(map[string]string, len(globalSources))
+	secretFields := make(map[string]bool)
+	for name, src := range globalSources {
+		sources[name] = src.String()
+		if src.Source == SourceSecret {
+			secretFields[name] = true
+		}
+	}
+	configMutex.RUnlock()
+
+	if cfg == nil {
+		http.Error(w, "configuration not initialized", http.StatusServiceUnavailable)
+		return
+	}
+
+	values, err := redactedValues(cfg, secretFields)
+	if err != nil {
+		http.Error(w, "failed to read configuration", http.StatusInternalServerError)
+		return
+	}
+
+	var lastReloadValue interface{}
+	if !reloaded.IsZero() {
+		lastReloadValue = reloaded.UTC().Format(time.RFC3339)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"config":      values,
+		"sources":     sources,
+		"last_reload": lastReloadValue,
+		"timestamp":   time.Now().UTC().Format(time.RFC3339),
+	}); err != nil {
+		log.Error("Failed to encode configuration response", log.Field{Key: "error", Value: err})
+	}
+}
+
+// redactedValues returns the fields of cfg keyed by Go field name, with
+// secret values replaced. A field is secret if it is tagged `secret:"true"`
+// or listed in secretFields.
+func redactedValues(cfg *Config, secretFields map[string]bool) (map[string]interface{}, error) {
+	snapshot := *cfg
+	fields, err := collectFields(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		value := f.Value.Interface()
+		switch {
+		case f.Field.Tag.Get("secret") == "true" || secretFields[f.Name]:
+			if !f.Value.IsZero() {
+				value = redactedValue
+			}
+		case f.Value.Type() == reflect.TypeOf(time.Duration(0)):
+			value = time.Duration(f.Value.Int()).String()
+		}
+		values[f.Name] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// configzResponse mirrors the JSON document served by Handler.
+type configzResponse struct {
+	Config     map[string]interface{} `json:"config"`
+	Sources    map[string]string      `json:"sources"`
+	LastReload *string                `json:"last_reload"`
+}
+
+// getConfigz performs a request against Handler and decodes the response.
+func getConfigz(t *testing.T) (*httptest.ResponseRecorder, configzResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/configz", nil))
+
+	var body configzResponse
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	}
+	return rec, body
+}
+
+// TestHandler_DumpsConfigWithRedaction tests the configuration dump.
+// This verifies secret-tagged fields are redacted and other values are shown as-is.
+func TestHandler_DumpsConfigWithRedaction(t *testing.T) {
+	cfg := newValidTestConfig()
+	cfg.DatabaseDSN = "user:hunter2@tcp(db:3306)/app"
+	cfg.K8sResyncPeriod = 45 * time.Second
+	Set(cfg)
+	SetSources(map[string]ValueSource{
+		"MetricsPort": {Source: SourceEnv, Key: "METRICS_PORT"},
+	})
+	defer func() {
+		Set(nil)
+		SetSources(nil)
+	}()
+
+	rec, body := getConfigz(t)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.NotContains(t, rec.Body.String(), "hunter2")
+
+	assert.Equal(t, "test-service", body.Config["ServiceName"])
+	assert.Equal(t, float64(9091), body.Config["MetricsPort"])
+	assert.Equal(t, "45s", body.Config["K8sResyncPeriod"])
+	assert.Equal(t, redactedValue, body.Config["DatabaseDSN"])
+	assert.Equal(t, "env: METRICS_PORT", body.Sources["MetricsPort"])
+	require.NotNil(t, body.LastReload)
+}
+
+// TestHandler_EmptySecretNotRedacted tests that unset secret fields stay empty.
+// This verifies operators can tell an unset secret from a configured one.
+func TestHandler_EmptySecretNotRedacted(t *testing.T) {
+	Set(newValidTestConfig())
+	defer Set(nil)
+
+	_, body := getConfigz(t)
+
+	assert.Equal(t, "", body.Config["DatabaseDSN"])
+}
+
+// TestHandler_RedactsSecretSourcedFields tests redaction of values applied from Secrets.
+// This verifies untagged fields are hidden once a Secret has supplied them.
+func TestHandler_RedactsSecretSourcedFields(t *testing.T) {
+	Set(newValidTestConfig())
+	SetSources(nil)
+	defer func() {
+		Set(nil)
+		SetSources(nil)
+	}()
+
+	err := UpdateFrom(ValueSource{Source: SourceSecret, Origin: "app-secret"}, map[string]string{
+		"ENVIRONMENT": "private-staging",
+	})
+	require.NoError(t, err)
+
+	rec, body := getConfigz(t)
+
+	assert.NotContains(t, rec.Body.String(), "private-staging")
+	assert.Equal(t, redactedValue, body.Config["Environment"])
+	assert.Equal(t, "secret app-secret: ENVIRONMENT", body.Sources["Environment"])
+}
+
+// TestUpdateFrom_SecretValuesNotInErrors tests rejected updates from a Secret.
+// This verifies the rejected values never appear in the returned error.
+func TestUpdateFrom_SecretValuesNotInErrors(t *testing.T) {
+	Set(newValidTestConfig())
+	defer Set(nil)
+
+	tests := []struct {
+		update map[string]string
+		value  string
+	}{
+		{map[string]string{"LOG_LEVEL": "s3cr3t-level"}, "s3cr3t-level"},
+		{map[string]string{"LOG_FORMAT": "s3cr3t-format"}, "s3cr3t-format"},
+		{map[string]string{"BUSINESS_HTTP_PORT": "70123"}, "70123"},
+		{map[string]string{"BUSINESS_HTTP_PORT": "9091"}, "9091"},
+		{map[string]string{"DATABASE_MAX_OPEN_CONNS": "s3cr3t-conns"}, "s3cr3t-conns"},
+		{map[string]string{"DATABASE_MAX_IDLE_CONNS": "4242"}, "4242"},
+	}
+	for _, tt := range tests {
+		err := UpdateFrom(ValueSource{Source: SourceSecret, Origin: "app-secret"}, tt.update)
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), tt.value)
+	}
+}
+
+// TestHandler_NotInitialized tests the response before configuration is set.
+// This verifies the endpoint reports unavailability instead of an empty dump.
+func TestHandler_NotInitialized(t *testing.T) {
+	Set(nil)
+
+	rec, _ := getConfigz(t)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+// TestHandler_MethodNotAllowed tests that only read methods are accepted.
+// This verifies the endpoint cannot be mistaken for a configuration API.
+func TestHandler_MethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/configz", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+}
+
+// TestUpdateFrom_RecordsSources tests source and reload tracking on updates.
+// This verifies only changed fields are attributed and the reload time advances.
+func TestUpdateFrom_RecordsSources(t *testing.T) {
+	Set(newValidTestConfig())
+	SetSources(map[string]ValueSource{
+		"LogLevel":    {Source: SourceEnv, Key: "LOG_LEVEL"},
+		"MetricsPort": {Source: SourceEnv, Key: "METRICS_PORT"},
+	})
+	defer func() {
+		Set(nil)
+		SetSources(nil)
+	}()
+	before := LastReload()
+
+	err := UpdateFrom(ValueSource{Source: SourceConfigMap, Origin: "app-config"}, map[string]string{
+		"log_level":    "debug",
+		"METRICS_PORT": "9091", // unchanged
+	})
+	require.NoError(t, err)
+
+	sources := Sources()
+	assert.Equal(t, ValueSource{Source: SourceConfigMap, Origin: "app-config", Key: "LOG_LEVEL"}, sources["LogLevel"])
+	assert.Equal(t, ValueSource{Source: SourceEnv, Key: "METRICS_PORT"}, sources["MetricsPort"])
+	assert.False(t, LastReload().Before(before))
+
+	require.NoError(t, Update(map[string]string{"LOG_LEVEL": "warn"}))
+	assert.Equal(t, SourceUpdate, Sources()["LogLevel"].Source)
+}
@@ -3,37 +3,94 @@ package config
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
 )
 
-// K8sConfigWatcher monitors Kubernetes ConfigMaps for configuration changes.
+// K8sConfigWatcher monitors a Kubernetes ConfigMap or Secret for configuration changes.
 // It uses the Kubernetes informer pattern to efficiently watch for updates
 // without polling, and triggers callbacks when changes are detected.
 //
+// Secret values are decoded to strings before being passed to the update
+// callback and are never written to logs; only their keys are logged.
+//
 // Thread Safety: The watcher is safe for concurrent use. Update callbacks
 // may be invoked from multiple goroutines.
 //
 // Resource Usage: The watcher maintains a connection to the Kubernetes API
-// server and keeps a local cache of ConfigMap data.
+// server and keeps a local cache of the watched resource.
+//
+// Implements the service.Service interface for registration with a launcher,
+// and the monitoring.HealthChecker interface for reporting its sync status.
 type K8sConfigWatcher struct {
-	clientset   *kubernetes.Clientset
-	namespace   string
-	configMap   string
-	updateFunc  func(map[string]string)
-	stopCh      chan struct{}
-	informer    cache.SharedIndexInformer
-	initialized bool
+	clientset        kubernetes.Interface
+	kind             resourceKind
+	namespace        string
+	name             string
+	updateFunc       func(map[string]string)
+	stopCh           chan struct{}
+	stopOnce         sync.Once
+	informer         cache.SharedIndexInformer
+	initialized      bool
+	resyncPeriod     time.Duration
+	cacheSyncTimeout time.Duration
+
+	// mu protects informer, initialized and the timing settings
+	mu sync.RWMutex
+
+	// lastHash is the hash of the most recently delivered resource data
+	lastHash string
+
+	// hashMu protects lastHash
+	hashMu sync.Mutex
 }
 
+// resourceKind identifies the Kubernetes resource type observed by a watcher.
+type resourceKind int
+
+const (
+	// kindConfigMap watches a ConfigMap; this is the zero value.
+	kindConfigMap resourceKind = iota
+
+	// kindSecret watches a Secret.
+	kindSecret
+)
+
+// String returns the lowercase Kubernetes resource name.
+func (k resourceKind) String() string {
+	if k == kindSecret {
+		return "secret"
+	}
+	return "configmap"
+}
+
+const (
+	// defaultResyncPeriod is the informer resync period used unless overridden.
+	defaultResyncPeriod = 30 * time.Second
+
+	// defaultCacheSyncTimeout bounds the wait for the initial cache sync.
+	defaultCacheSyncTimeout = 30 * time.Second
+)
+
 // NewK8sConfigWatcher creates a new Kubernetes ConfigMap watcher.
-// It establishes a connection to the Kubernetes API server and prepares
-// to watch the specified ConfigMap for changes.
+// It establishes a connection to the Kubernetes API server using the
+// in-cluster service account and prepares to watch the specified ConfigMap
+// for changes.
 //
 // Parameters:
 //   - namespace: Kubernetes namespace containing the ConfigMap
@@ -46,7 +103,7 @@ type K8sConfigWatcher struct {
 //   - error: Returns error if Kubernetes API connection fails
 //
 // Required Permissions:
-//   - The service account must have 'get' and 'watch' permissions
+//   - The service account must have 'get', 'list' and 'watch' permissions
 //     on ConfigMaps in the specified namespace
 //
 // Example:
@@ -55,14 +112,15 @@ type K8sConfigWatcher struct {
 //	    "default",
 //	    "my-service-config",
 //	    func(data map[string]string) {
-//	        log.Printf("Config updated: %v", data)
-//	        config.Update(data)
+//	        if err := config.Update(data); err != nil {
+//	            log.Printf("Config update rejected: %v", err)
+//	        }
 //	    },
 //	)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-//	go watcher.Start()
+//	go watcher.Start(ctx)
 func NewK8sConfigWatcher(namespace, configMapName string, updateFunc func(map[string]string)) (*K8sConfigWatcher, error) {
 	// Create in-cluster Kubernetes client configuration
 	kubeConfig, err := rest.InClusterConfig()
@@ -70,33 +128,231 @@ func NewK8sConfigWatcher(namespace, configMapName string, updateFunc func(map[st
 		return nil, fmt.Errorf("failed to create in-cluster config: %w", err)
 	}
 
+	return newWatcherForConfig(kubeConfig, kindConfigMap, namespace, configMapName, updateFunc)
+}
+
+// NewK8sConfigWatcherFromKubeconfig creates a ConfigMap watcher that connects
+// using a kubeconfig file instead of the in-cluster service account.
+// This is intended for local development against a remote cluster.
+//
+// Parameters:
+//   - kubeconfigPath: Path to the kubeconfig file (e.g., ~/.kube/config)
+//   - namespace: Kubernetes namespace containing the ConfigMap
+//   - configMapName: Name of the ConfigMap to watch
+//   - updateFunc: Callback function invoked when ConfigMap data changes
+//
+// Returns:
+//   - *K8sConfigWatcher: Configured watcher instance
+//   - error: Returns error if the kubeconfig cannot be loaded
+//
+// Example:
+//
+//	watcher, err := config.NewK8sConfigWatcherFromKubeconfig(
+//	    os.Getenv("KUBECONFIG"), "default", "my-service-config", onUpdate)
+func NewK8sConfigWatcherFromKubeconfig(kubeconfigPath, namespace, configMapName string, updateFunc func(map[string]string)) (*K8sConfigWatcher, error) {
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig %s: %w", kubeconfigPath, err)
+	}
+
+	return newWatcherForConfig(kubeConfig, kindConfigMap, namespace, configMapName, updateFunc)
+}
+
+// NewK8sConfigWatcherWithClient creates a ConfigMap watcher backed by the
+// provided Kubernetes client. This allows the watcher to run against any
+// kubernetes.Interface implementation, including client-go's fake clientset
+// in unit tests.
+//
+// Parameters:
+//   - client: Kubernetes client used for listing and watching ConfigMaps
+//   - namespace: Kubernetes namespace containing the ConfigMap
+//   - configMapName: Name of the ConfigMap to watch
+//   - updateFunc: Callback function invoked when ConfigMap data changes
+//
+// Returns:
+//   - *K8sConfigWatcher: Configured watcher instance
+//
+// Example:
+//
+//	client := fake.NewSimpleClientset(configMap)
+//	watcher := config.NewK8sConfigWatcherWithClient(client, "default", "app-config", onUpdate)
+func NewK8sConfigWatcherWithClient(client kubernetes.Interface, namespace, configMapName string, updateFunc func(map[string]string)) *K8sConfigWatcher {
+	return newWatcher(client, kindConfigMap, namespace, configMapName, updateFunc)
+}
+
+// NewK8sSecretWatcher creates a new Kubernetes Secret watcher using the
+// in-cluster service account. Secret data is decoded to strings and passed
+// to updateFunc, so the same validated update path used for ConfigMaps
+// (e.g., config.Update) can apply values such as DATABASE_DSN.
+//
+// Parameters:
+//   - namespace: Kubernetes namespace containing the Secret
+//   - secretName: Name of the Secret to watch
+//   - updateFunc: Callback function invoked when Secret data changes
+//
+// Returns:
+//   - *K8sConfigWatcher: Configured watcher instance
+//   - error: Returns error if Kubernetes API connection fails
+//
+// Required Permissions:
+//   - The service account must have 'get', 'list' and 'watch' permissions
+//     on Secrets in the specified namespace
+//
+// Example:
+//
+//	watcher, err := config.NewK8sSecretWatcher("default", "my-service-secrets",
+//	    func(data map[string]string) {
+//	        _ = config.Update(data)
+//	    })
+func NewK8sSecretWatcher(namespace, secretName string, updateFunc func(map[string]string)) (*K8sConfigWatcher, error) {
+	kubeConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-cluster config: %w", err)
+	}
+
+	return newWatcherForConfig(kubeConfig, kindSecret, namespace, secretName, updateFunc)
+}
+
+// NewK8sSecretWatcherFromKubeconfig creates a Secret watcher that connects
+// using a kubeconfig file instead of the in-cluster service account.
+//
+// Parameters:
+//   - kubeconfigPath: Path to the kubeconfig file
+//   - namespace: Kubernetes namespace containing the Secret
+//   - secretName: Name of the Secret to watch
+//   - updateFunc: Callback function invoked when Secret data changes
+//
+// Returns:
+//   - *K8sConfigWatcher: Configured watcher instance
+//   - error: Returns error if the kubeconfig cannot be loaded
+func NewK8sSecretWatcherFromKubeconfig(kubeconfigPath, namespace, secretName string, updateFunc func(map[string]string)) (*K8sConfigWatcher, error) {
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig %s: %w", kubeconfigPath, err)
+	}
+
+	return newWatcherForConfig(kubeConfig, kindSecret, namespace, secretName, updateFunc)
+}
+
+// NewK8sSecretWatcherWithClient creates a Secret watcher backed by the
+// provided Kubernetes client, such as client-go's fake clientset.
+//
+// Parameters:
+//   - client: Kubernetes client used for listing and watching Secrets
+//   - namespace: Kubernetes namespace containing the Secret
+//   - secretName: Name of the Secret to watch
+//   - updateFunc: Callback function invoked when Secret data changes
+//
+// Returns:
+//   - *K8sConfigWatcher: Configured watcher instance
+func NewK8sSecretWatcherWithClient(client kubernetes.Interface, namespace, secretName string, updateFunc func(map[string]string)) *K8sConfigWatcher {
+	return newWatcher(client, kindSecret, namespace, secretName, updateFunc)
+}
+
+// newWatcher creates a watcher for a single named resource of the given kind.
+func newWatcher(client kubernetes.Interface, kind resourceKind, namespace, name string, updateFunc func(map[string]string)) *K8sConfigWatcher {
+	return &K8sConfigWatcher{
+		clientset:        client,
+		kind:             kind,
+		namespace:        namespace,
+		name:             name,
+		updateFunc:       updateFunc,
+		stopCh:           make(chan struct{}),
+		resyncPeriod:     defaultResyncPeriod,
+		cacheSyncTimeout: defaultCacheSyncTimeout,
+	}
+}
+
+// newWatcherForConfig builds a clientset from a REST config and wraps it in a watcher.
+func newWatcherForConfig(kubeConfig *rest.Config, kind resourceKind, namespace, name string, updateFunc func(map[string]string)) (*K8sConfigWatcher, error) {
 	// Build Kubernetes clientset for API access
 	clientset, err := kubernetes.NewForConfig(kubeConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kubernetes clientset: %w", err)
 	}
 
-	return &K8sConfigWatcher{
-		clientset:  clientset,
-		namespace:  namespace,
-		configMap:  configMapName,
-		updateFunc: updateFunc,
-		stopCh:     make(chan struct{}),
-	}, nil
+	return newWatcher(clientset, kind, namespace, name, updateFunc), nil
+}
+
+// ReadConfigMapData fetches the current data of a ConfigMap once.
+// It is intended for seeding the ConfigMap layer of a Loader at startup,
+// before a watcher takes over dynamic updates.
+//
+// Parameters:
+//   - ctx: Context for the API request
+//   - client: Kubernetes client
+//   - namespace: Kubernetes namespace containing the ConfigMap
+//   - name: Name of the ConfigMap
+//
+// Returns:
+//   - map[string]string: The ConfigMap data (never nil on success)
+//   - error: Returns error if the ConfigMap cannot be fetched
+//
+// Example:
+//
+//	data, err := config.ReadConfigMapData(ctx, client, "default", "my-service-config")
+//	if err != nil {
+//	    return err
+//	}
+//	loader.SetConfigMap("my-service-config", data)
+func ReadConfigMapData(ctx context.Context, client kubernetes.Interface, namespace, name string) (map[string]string, error) {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s/%s: %w", namespace, name, err)
+	}
+
+	data := make(map[string]string, len(cm.Data))
+	for k, v := range cm.Data {
+		data[k] = v
+	}
+	return data, nil
+}
+
+// SetResyncPeriod configures how often the informer replays the cached
+// ConfigMap. Replays with unchanged data do not invoke the update callback.
+// Must be called before Start.
+//
+// Parameters:
+//   - period: Resync interval; zero disables periodic resync
+//
+// Default: 30 seconds
+func (w *K8sConfigWatcher) SetResyncPeriod(period time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.resyncPeriod = period
+}
+
+// SetCacheSyncTimeout configures the maximum time Start waits for the
+// initial ConfigMap cache sync before failing. Must be called before Start.
+//
+// Parameters:
+//   - timeout: Maximum wait duration; zero or negative restores the default
+//
+// Default: 30 seconds
+func (w *K8sConfigWatcher) SetCacheSyncTimeout(timeout time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if timeout <= 0 {
+		timeout = defaultCacheSyncTimeout
+	}
+	w.cacheSyncTimeout = timeout
 }
 
-// Start begins watching the ConfigMap for changes.
+// Start begins watching the ConfigMap or Secret for changes.
 // This method blocks until the watcher is stopped via Stop() or context cancellation.
 //
 // The watcher will:
 //  1. Initialize connection to Kubernetes API server
-//  2. Load initial ConfigMap state
+//  2. Load initial resource state
 //  3. Invoke update callback with initial data
 //  4. Watch for subsequent changes and invoke callback on updates
 //
 // Error Handling: If the initial load fails, returns error immediately.
 // Runtime errors are logged but don't stop the watcher.
 //
+// Parameters:
+//   - ctx: Context for cancellation; canceling it stops the watcher
+//
 // Returns:
 //   - error: Returns error if initial setup or load fails
 //
@@ -104,45 +360,68 @@ func NewK8sConfigWatcher(namespace, configMapName string, updateFunc func(map[st
 //
 //	watcher, _ := config.NewK8sConfigWatcher(...)
 //	go func() {
-//	    if err := watcher.Start(); err != nil {
+//	    if err := watcher.Start(ctx); err != nil {
 //	        log.Printf("Watcher failed: %v", err)
 //	    }
 //	}()
-func (w *K8sConfigWatcher) Start() error {
+func (w *K8sConfigWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
 	if w.initialized {
+		w.mu.Unlock()
 		return fmt.Errorf("watcher already started")
 	}
 
-	// Create shared informer factory for ConfigMaps in the namespace
+	// Create shared informer factory scoped to the single watched resource
+	nameSelector := fields.OneTermEqualSelector("metadata.name", w.name).String()
 	factory := informers.NewSharedInformerFactoryWithOptions(
 		w.clientset,
-		30*time.Second, // Resync period
+		w.resyncPeriod,
 		informers.WithNamespace(w.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = nameSelector
+		}),
 	)
 
-	// Get ConfigMap informer
-	w.informer = factory.Core().V1().ConfigMaps().Informer()
+	// Get informer for the watched resource kind
+	var informer cache.SharedIndexInformer
+	if w.kind == kindSecret {
+		informer = factory.Core().V1().Secrets().Informer()
+	} else {
+		informer = factory.Core().V1().ConfigMaps().Informer()
+	}
 
-	// Register event handlers for ConfigMap changes
-	if _, err := w.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	// Register event handlers for resource changes
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    w.handleAdd,
 		UpdateFunc: w.handleUpdate,
 		DeleteFunc: w.handleDelete,
 	}); err != nil {
+		w.mu.Unlock()
 		return fmt.Errorf("failed to add event handler: %w", err)
 	}
 
+	w.informer = informer
 	w.initialized = true
+	cacheSyncTimeout := w.cacheSyncTimeout
+	w.mu.Unlock()
+
+	// Stop the informer when the caller's context ends
+	stopWatchingCtx := context.AfterFunc(ctx, w.stop)
+	defer stopWatchingCtx()
 
 	// Start informer and wait for initial sync
-	go w.informer.Run(w.stopCh)
+	go informer.Run(w.stopCh)
 
 	// Wait for cache sync with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	syncCtx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
 	defer cancel()
 
-	if !cache.WaitForCacheSync(ctx.Done(), w.informer.HasSynced) {
-		return fmt.Errorf("failed to sync configmap cache")
+	if !cache.WaitForCacheSync(mergeDone(syncCtx.Done(), w.stopCh), informer.HasSynced) {
+		if ctx.Err() != nil || w.isStopped() {
+			return nil
+		}
+		w.stop()
+		return fmt.Errorf("failed to sync %s cache", w.kind)
 	}
 
 	// Block until stop signal
@@ -150,49 +429,233 @@ func (w *K8sConfigWatcher) Start() error {
 	return nil
 }
 
-// Stop halts the ConfigMap watcher and releases resources.
-// This method is safe to call multiple times.
+// Stop halts the watcher and releases resources.
+// This method is safe to call multiple times and concurrently with Start.
 //
 // After calling Stop, the watcher cannot be restarted.
 // Create a new watcher instance if needed.
-func (w *K8sConfigWatcher) Stop() {
-	if w.stopCh != nil {
+//
+// Parameters:
+//   - ctx: Context for timeout control (unused; stopping is immediate)
+//
+// Returns:
+//   - error: Always nil; provided for compatibility with the Service interface
+func (w *K8sConfigWatcher) Stop(ctx context.Context) error {
+	w.stop()
+	return nil
+}
+
+// Reload fetches the watched resource directly from the API server and
+// applies its data through the update callback, even if it matches the last
+// delivered data. Use it to force a reload, e.g. on SIGHUP, without waiting
+// for the next watch event or resync.
+//
+// Parameters:
+//   - ctx: Context for the API request
+//
+// Returns:
+//   - error: Returns error if the resource cannot be fetched
+//
+// Example:
+//
+//	launcher.SetReloadFunc(watcher.Reload)
+func (w *K8sConfigWatcher) Reload(ctx context.Context) error {
+	var obj interface{}
+	var err error
+	if w.kind == kindSecret {
+		obj, err = w.clientset.CoreV1().Secrets(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+	} else {
+		obj, err = w.clientset.CoreV1().ConfigMaps(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s/%s: %w", w.kind, w.namespace, w.name, err)
+	}
+
+	// Forget the delivered data so unchanged data is applied again
+	w.hashMu.Lock()
+	w.lastHash = ""
+	w.hashMu.Unlock()
+
+	w.processObject(obj)
+	return nil
+}
+
+// Name returns the health checker identifier for this watcher.
+//
+// Returns:
+//   - string: Identifier including the resource kind, namespace and name
+func (w *K8sConfigWatcher) Name() string {
+	return fmt.Sprintf("k8s-%s/%s/%s", w.kind, w.namespace, w.name)
+}
+
+// Check reports whether the watcher has completed its initial sync.
+// Used by the health service /readyz endpoint.
+//
+// Parameters:
+//   - ctx: Context for the health check (unused)
+//
+// Returns:
+//   - error: Returns error if the watcher is not started, stopped, or not yet synced
+func (w *K8sConfigWatcher) Check(ctx context.Context) error {
+	w.mu.RLock()
+	informer := w.informer
+	w.mu.RUnlock()
+
+	if informer == nil {
+		return fmt.Errorf("%s watcher not started", w.kind)
+	}
+	if w.isStopped() {
+		return fmt.Errorf("%s watcher stopped", w.kind)
+	}
+	if !informer.HasSynced() {
+		return fmt.Errorf("%s cache not synced", w.kind)
+	}
+	return nil
+}
+
+// stop closes the stop channel exactly once.
+func (w *K8sConfigWatcher) stop() {
+	w.stopOnce.Do(func() {
 		close(w.stopCh)
+	})
+}
+
+// isStopped reports whether the stop channel has been closed.
+func (w *K8sConfigWatcher) isStopped() bool {
+	select {
+	case <-w.stopCh:
+		return true
+	default:
+		return false
 	}
 }
 
-// handleAdd processes ConfigMap creation events.
-// Invoked when the watched ConfigMap is first detected.
+// mergeDone returns a channel that is closed when either a or b is closed.
+func mergeDone(a <-chan struct{}, b <-chan struct{}) <-chan struct{} {
+	merged := make(chan struct{})
+	go func() {
+		defer close(merged)
+		select {
+		case <-a:
+		case <-b:
+		}
+	}()
+	return merged
+}
+
+// handleAdd processes resource creation events.
+// Invoked when the watched resource is first detected.
 func (w *K8sConfigWatcher) handleAdd(obj interface{}) {
-	w.processConfigMap(obj)
+	w.processObject(obj)
 }
 
-// handleUpdate processes ConfigMap modification events.
-// Invoked when the watched ConfigMap's data changes.
+// handleUpdate processes resource modification events.
+// Invoked when the watched resource changes or the informer resyncs.
 func (w *K8sConfigWatcher) handleUpdate(oldObj, newObj interface{}) {
-	w.processConfigMap(newObj)
+	w.processObject(newObj)
 }
 
-// handleDelete processes ConfigMap deletion events.
-// Invoked when the watched ConfigMap is removed.
+// handleDelete processes resource deletion events.
+// Invoked when the watched resource is removed. The current configuration
+// is kept as-is, since an absent resource carries no values to apply.
 func (w *K8sConfigWatcher) handleDelete(obj interface{}) {
-	// ConfigMap deleted - could notify about empty config
-	// For now, we don't trigger updates on deletion
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	meta, _, ok := w.extract(obj)
+	if !ok {
+		return
+	}
+
+	// Forget the delivered data so a re-created resource is applied again
+	w.hashMu.Lock()
+	w.lastHash = ""
+	w.hashMu.Unlock()
+
+	log.Warn("Watched resource deleted, keeping current configuration",
+		log.Field{Key: "kind", Value: w.kind.String()},
+		log.Field{Key: "namespace", Value: meta.Namespace},
+		log.Field{Key: "name", Value: meta.Name})
+}
+
+// processObject extracts data from the watched resource and triggers the
+// update callback. Objects of another kind or that refer to a different
+// resource than the watched one are ignored, as are events whose data is
+// identical to the last delivered data (e.g., periodic resyncs or
+// metadata-only edits).
+func (w *K8sConfigWatcher) processObject(obj interface{}) {
+	_, data, ok := w.extract(obj)
+	if !ok {
+		return
+	}
+
+	hash := hashData(data)
+
+	w.hashMu.Lock()
+	if hash == w.lastHash {
+		w.hashMu.Unlock()
+		return
+	}
+	w.lastHash = hash
+	w.hashMu.Unlock()
+
+	if w.kind == kindSecret {
+		// Never log secret values
+		log.Info("Watched secret changed",
+			log.Field{Key: "namespace", Value: w.namespace},
+			log.Field{Key: "name", Value: w.name},
+			log.Field{Key: "keys", Value: sortedKeys(data)},
+			log.Field{Key: "values", Value: "<redacted>"})
+	}
+
+	if w.updateFunc != nil {
+		w.updateFunc(data)
+	}
 }
 
-// processConfigMap extracts data from ConfigMap and triggers update callback.
-// This internal method handles type assertions and data extraction.
-func (w *K8sConfigWatcher) processConfigMap(obj interface{}) {
-	// Type assertion to get ConfigMap object
-	// In real implementation, would use proper type from k8s.io/api/core/v1
-	// For now, using interface{} to avoid import complexity in this demo
+// extract returns the metadata and string data of obj if it is the watched
+// resource. Secret data is decoded from bytes to strings.
+func (w *K8sConfigWatcher) extract(obj interface{}) (metav1.ObjectMeta, map[string]string, bool) {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		if w.kind != kindConfigMap || o.Name != w.name {
+			return metav1.ObjectMeta{}, nil, false
+		}
+		return o.ObjectMeta, o.Data, true
 
-	// TODO: Add proper ConfigMap type handling
-	// configMap, ok := obj.(*corev1.ConfigMap)
-	// if !ok {
-	//     return
-	// }
+	case *corev1.Secret:
+		if w.kind != kindSecret || o.Name != w.name {
+			return metav1.ObjectMeta{}, nil, false
+		}
+		data := make(map[string]string, len(o.Data))
+		for k, v := range o.Data {
+			data[k] = string(v)
+		}
+		return o.ObjectMeta, data, true
 
-	// Extract data and invoke callback
-	// w.updateFunc(configMap.Data)
+	default:
+		return metav1.ObjectMeta{}, nil, false
+	}
+}
+
+// sortedKeys returns the keys of data in sorted order.
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// hashData returns a stable SHA-256 digest of a string map.
+// Keys are sorted so that the digest does not depend on map iteration order.
+func hashData(data map[string]string) string {
+	h := sha256.New()
+	for _, k := range sortedKeys(data) {
+		// Length-prefix entries so that key/value boundaries are unambiguous
+		fmt.Fprintf(h, "%d:%s=%d:%s;", len(k), k, len(data[k]), data[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
@@ -0,0 +1,383 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// TestProcessConfigMap_InvokesCallback tests that ConfigMap data reaches the callback.
+// This is an isolated method test with no Kubernetes API dependency.
+func TestProcessConfigMap_InvokesCallback(t *testing.T) {
+	var received map[string]string
+	w := &K8sConfigWatcher{
+		namespace:  "default",
+		name:       "app-config",
+		updateFunc: func(data map[string]string) { received = data },
+	}
+
+	w.processObject(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, received)
+}
+
+// TestProcessConfigMap_IgnoresOtherConfigMaps tests name filtering.
+// This verifies events for unrelated ConfigMaps do not trigger updates.
+func TestProcessConfigMap_IgnoresOtherConfigMaps(t *testing.T) {
+	called := false
+	w := &K8sConfigWatcher{
+		name:       "app-config",
+		updateFunc: func(map[string]string) { called = true },
+	}
+
+	w.processObject(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-config"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+	w.processObject("not a configmap")
+
+	assert.False(t, called)
+}
+
+// TestProcessConfigMap_UpdatesGlobalConfig tests the watcher-to-Update path.
+// This verifies ConfigMap keys are applied to the global configuration.
+func TestProcessConfigMap_UpdatesGlobalConfig(t *testing.T) {
+	Set(newValidTestConfig())
+	defer Set(nil)
+
+	w := &K8sConfigWatcher{
+		name: "app-config",
+		updateFunc: func(data map[string]string) {
+			_ = Update(data)
+		},
+	}
+
+	w.processObject(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config"},
+		Data:       map[string]string{"LOG_LEVEL": "error"},
+	})
+
+	assert.Equal(t, "error", Get().LogLevel)
+}
+
+// TestK8sConfigWatcher_StopTwice tests that Stop is idempotent.
+// This verifies the stop channel is never closed twice.
+func TestK8sConfigWatcher_StopTwice(t *testing.T) {
+	w := &K8sConfigWatcher{stopCh: make(chan struct{})}
+
+	assert.NotPanics(t, func() {
+		assert.NoError(t, w.Stop(context.Background()))
+		assert.NoError(t, w.Stop(context.Background()))
+	})
+}
+
+// TestK8sConfigWatcher_CheckNotStarted tests sync status before Start.
+// This verifies the health check fails until the informer is running.
+func TestK8sConfigWatcher_CheckNotStarted(t *testing.T) {
+	w := &K8sConfigWatcher{
+		namespace: "default",
+		name:      "app-config",
+		stopCh:    make(chan struct{}),
+	}
+
+	err := w.Check(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not started")
+	assert.Equal(t, "k8s-configmap/default/app-config", w.Name())
+}
+
+// TestK8sConfigWatcher_FakeClient_AddUpdateDelete tests the full watch lifecycle.
+// This is an integration test against client-go's fake clientset.
+func TestK8sConfigWatcher_FakeClient_AddUpdateDelete(t *testing.T) {
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+
+	updates := make(chan map[string]string, 10)
+	w := NewK8sConfigWatcherWithClient(client, "default", "app-config", func(data map[string]string) {
+		updates <- data
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Start(ctx)
+	}()
+
+	// Initial state is delivered on add
+	select {
+	case data := <-updates:
+		assert.Equal(t, "debug", data["LOG_LEVEL"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for initial ConfigMap data")
+	}
+
+	assert.Eventually(t, func() bool {
+		return w.Check(ctx) == nil
+	}, 5*time.Second, 10*time.Millisecond, "Watcher should report synced")
+
+	// Modifications are delivered on update
+	_, err := client.CoreV1().ConfigMaps("default").Update(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "warn"},
+	}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case data := <-updates:
+		assert.Equal(t, "warn", data["LOG_LEVEL"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for updated ConfigMap data")
+	}
+
+	// Deletion does not trigger an update
+	err = client.CoreV1().ConfigMaps("default").Delete(ctx, "app-config", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	select {
+	case data := <-updates:
+		t.Fatalf("Unexpected update after delete: %v", data)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Canceling the context stops the watcher
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for watcher to stop")
+	}
+	assert.Error(t, w.Check(context.Background()), "Stopped watcher should report unhealthy")
+}
+
+// TestK8sConfigWatcher_FakeClient_StartTwice tests duplicate Start protection.
+// This verifies a watcher cannot be started more than once.
+func TestK8sConfigWatcher_FakeClient_StartTwice(t *testing.T) {
+	w := NewK8sConfigWatcherWithClient(fake.NewClientset(), "default", "app-config", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() { _ = w.Start(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return w.Check(ctx) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err := w.Start(ctx)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already started")
+}
+
+// TestNewK8sConfigWatcherFromKubeconfig_MissingFile tests kubeconfig loading errors.
+// This verifies a descriptive error is returned for unreadable kubeconfig files.
+func TestNewK8sConfigWatcherFromKubeconfig_MissingFile(t *testing.T) {
+	w, err := NewK8sConfigWatcherFromKubeconfig("/nonexistent/kubeconfig", "default", "app-config", nil)
+
+	assert.Nil(t, w)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load kubeconfig")
+}
+
+// TestProcessConfigMap_SkipsUnchangedData tests hash-based change detection.
+// This verifies resyncs and metadata-only edits do not re-trigger the callback.
+func TestProcessConfigMap_SkipsUnchangedData(t *testing.T) {
+	calls := 0
+	w := &K8sConfigWatcher{
+		name:       "app-config",
+		updateFunc: func(map[string]string) { calls++ },
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config"},
+		Data:       map[string]string{"LOG_LEVEL": "debug", "METRICS_PORT": "9100"},
+	}
+	w.processObject(cm)
+	w.handleUpdate(cm, cm) // Resync replays the same object
+
+	relabeled := cm.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "core"}
+	w.handleUpdate(cm, relabeled)
+
+	assert.Equal(t, 1, calls, "Unchanged data must not invoke the callback again")
+
+	changed := cm.DeepCopy()
+	changed.Data["LOG_LEVEL"] = "info"
+	w.handleUpdate(cm, changed)
+
+	assert.Equal(t, 2, calls)
+}
+
+// TestProcessConfigMap_RecreatedAfterDelete tests delivery after re-creation.
+// This verifies a deleted and re-created ConfigMap is applied again.
+func TestProcessConfigMap_RecreatedAfterDelete(t *testing.T) {
+	calls := 0
+	w := &K8sConfigWatcher{
+		name:       "app-config",
+		updateFunc: func(map[string]string) { calls++ },
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	}
+	w.handleAdd(cm)
+	w.handleDelete(cm)
+	w.handleAdd(cm)
+
+	assert.Equal(t, 2, calls)
+}
+
+// TestHashData_OrderIndependent tests that hashing ignores map iteration order.
+// This is an isolated function test with no external dependencies.
+func TestHashData_OrderIndependent(t *testing.T) {
+	a := map[string]string{"A": "1", "B": "2", "C": "3"}
+	b := map[string]string{"C": "3", "A": "1", "B": "2"}
+
+	assert.Equal(t, hashData(a), hashData(b))
+	assert.NotEqual(t, hashData(a), hashData(map[string]string{"A": "12", "B": "", "C": "3"}))
+}
+
+// TestK8sConfigWatcher_Setters tests resync and cache-sync configuration.
+// This is an isolated method test with no external dependencies.
+func TestK8sConfigWatcher_Setters(t *testing.T) {
+	w := NewK8sConfigWatcherWithClient(fake.NewClientset(), "default", "app-config", nil)
+
+	assert.Equal(t, 30*time.Second, w.resyncPeriod)
+	assert.Equal(t, 30*time.Second, w.cacheSyncTimeout)
+
+	w.SetResyncPeriod(0)
+	w.SetCacheSyncTimeout(5 * time.Second)
+	assert.Equal(t, time.Duration(0), w.resyncPeriod)
+	assert.Equal(t, 5*time.Second, w.cacheSyncTimeout)
+
+	w.SetCacheSyncTimeout(0)
+	assert.Equal(t, 30*time.Second, w.cacheSyncTimeout, "Zero timeout restores the default")
+}
+
+// TestK8sConfigWatcher_FieldSelector tests that the informer is scoped by name.
+// This verifies list and watch requests only target the configured ConfigMap.
+func TestK8sConfigWatcher_FieldSelector(t *testing.T) {
+	client := fake.NewClientset()
+	w := NewK8sConfigWatcherWithClient(client, "default", "app-config", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = w.Start(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return w.Check(ctx) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var selectors []string
+	for _, action := range client.Actions() {
+		if list, ok := action.(k8stesting.ListAction); ok {
+			selectors = append(selectors, list.GetListRestrictions().Fields.String())
+		}
+	}
+
+	require.NotEmpty(t, selectors)
+	for _, selector := range selectors {
+		assert.Equal(t, "metadata.name=app-config", selector)
+	}
+}
+
+// TestK8sSecretWatcher_FakeClient_DecodesData tests Secret watching.
+// This verifies Secret bytes are decoded and applied through config.Update.
+func TestK8sSecretWatcher_FakeClient_DecodesData(t *testing.T) {
+	Set(newValidTestConfig())
+	defer Set(nil)
+
+	client := fake.NewClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-secrets", Namespace: "default"},
+		Data:       map[string][]byte{"DATABASE_DSN": []byte("user:pass@tcp(db:3306)/app")},
+	})
+
+	applied := make(chan struct{}, 1)
+	w := NewK8sSecretWatcherWithClient(client, "default", "app-secrets", func(data map[string]string) {
+		require.NoError(t, Update(data))
+		applied <- struct{}{}
+	})
+	assert.Equal(t, "k8s-secret/default/app-secrets", w.Name())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = w.Start(ctx) }()
+
+	select {
+	case <-applied:
+		assert.Equal(t, "user:pass@tcp(db:3306)/app", Get().DatabaseDSN)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for Secret data")
+	}
+}
+
+// TestK8sConfigWatcher_Reload tests forcing a reload from the API server.
+// This verifies Reload re-applies unchanged data and reports missing resources.
+func TestK8sConfigWatcher_Reload(t *testing.T) {
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+
+	var received []map[string]string
+	w := NewK8sConfigWatcherWithClient(client, "default", "app-config", func(data map[string]string) {
+		received = append(received, data)
+	})
+
+	require.NoError(t, w.Reload(context.Background()))
+	require.NoError(t, w.Reload(context.Background()))
+	require.Len(t, received, 2)
+	assert.Equal(t, "debug", received[1]["LOG_LEVEL"])
+
+	missing := NewK8sSecretWatcherWithClient(client, "default", "absent", nil)
+	err := missing.Reload(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get secret default/absent")
+}
+
+// TestProcessObject_KindMismatch tests that watchers ignore other resource kinds.
+// This verifies a Secret watcher never applies a ConfigMap of the same name.
+func TestProcessObject_KindMismatch(t *testing.T) {
+	called := false
+	w := &K8sConfigWatcher{
+		kind:       kindSecret,
+		name:       "shared-name",
+		updateFunc: func(map[string]string) { called = true },
+	}
+
+	w.processObject(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-name"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+
+	assert.False(t, called)
+}
+
+// TestUpdate_ErrorOmitsValue tests that conversion errors do not echo values.
+// This verifies secret material cannot leak through rejected-update logs.
+func TestUpdate_ErrorOmitsValue(t *testing.T) {
+	Set(newValidTestConfig())
+	defer Set(nil)
+
+	err := Update(map[string]string{"METRICS_PORT": "s3cr3t-value"})
+
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "s3cr3t-value")
+}
@@ -0,0 +1,363 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// Source identifies the configuration layer that supplied a value.
+type Source string
+
+const (
+	// SourceDefault marks values taken from `default` struct tags or left at
+	// their zero value.
+	SourceDefault Source = "default"
+
+	// SourceFile marks values read from a YAML, JSON or TOML file.
+	SourceFile Source = "file"
+
+	// SourceEnv marks values read from environment variables.
+	SourceEnv Source = "env"
+
+	// SourceConfigMap marks values read from Kubernetes ConfigMap data.
+	SourceConfigMap Source = "configmap"
+
+	// SourceFlag marks values read from command-line flags.
+	SourceFlag Source = "flag"
+
+	// SourceSecret marks values applied from a Kubernetes Secret at runtime.
+	// Such values are always redacted from configuration dumps.
+	SourceSecret Source = "secret"
+
+	// SourceUpdate marks values applied at runtime through Update.
+	SourceUpdate Source = "update"
+)
+
+// ValueSource records where the effective value of a field came from.
+type ValueSource struct {
+	// Source is the layer that supplied the value.
+	Source Source
+
+	// Origin names the concrete file or ConfigMap, if any.
+	Origin string
+
+	// Key is the key as written in the source (e.g., "metrics_port",
+	// "METRICS_PORT" or "--metrics-port"). Empty for defaults.
+	Key string
+}
+
+// String returns a human-readable description such as
+// "file config.yaml: metrics_port" or "env: METRICS_PORT".
+func (s ValueSource) String() string {
+	out := string(s.Source)
+	if s.Origin != "" {
+		out += " " + s.Origin
+	}
+	if s.Key != "" {
+		out += ": " + s.Key
+	}
+	return out
+}
+
+// Loader builds a configuration from several layered sources.
+// Later layers override earlier ones, in this order of precedence
+// (lowest first):
+//
+//  1. `default` struct tags
+//  2. Configuration files, in the order they were added
+//  3. Environment variables
+//  4. Kubernetes ConfigMap data
+//  5. Command-line flags
+//
+// Every layer addresses fields by their `envconfig` key. Files and ConfigMap
+// data match keys case-insensitively and treat '-' as '_', so "metrics_port",
+// "metrics-port" and "METRICS_PORT" are equivalent; files may also use the Go
+// field name and nest tables (database: {dsn: ...} sets DATABASE_DSN).
+// Flags are the lowercase kebab-case form of the key (--metrics-port).
+// Unknown keys in files and ConfigMap data are ignored.
+//
+// After Load, Sources reports which layer supplied each field.
+//
+// Thread Safety: A Loader is not safe for concurrent use.
+//
+// Example:
+//
+//	loader := config.NewLoader()
+//	loader.AddFile("config.yaml")
+//	loader.SetArgs(os.Args[1:])
+//
+//	var cfg config.Config
+//	if err := loader.Load(&cfg); err != nil {
+//	    log.Fatal("Failed to load config", log.Field{Key: "error", Value: err})
+//	}
+//	log.Info("Metrics port", log.Field{Key: "source", Value: loader.Sources()["MetricsPort"].String()})
+type Loader struct {
+	files         []string
+	configMapName string
+	configMapData map[string]string
+	args          []string
+	sources       map[string]ValueSource
+}
+
+// NewLoader creates a Loader that reads defaults and environment variables.
+// Files, ConfigMap data and flags are added with the corresponding setters.
+//
+// Returns:
+//   - *Loader: Loader with no files, ConfigMap data or flags
+func NewLoader() *Loader {
+	return &Loader{}
+}
+
+// AddFile adds a configuration file layer. The format is chosen by extension:
+// .yaml/.yml, .json or .toml. Tables, including inline tables, flatten into
+// '_'-joined keys; values must be strings, numbers or booleans.
+// Files added later override files added earlier.
+//
+// Parameters:
+//   - path: Path to the configuration file; the file must exist at Load time
+func (l *Loader) AddFile(path string) {
+	l.files = append(l.files, path)
+}
+
+// SetConfigMap sets the Kubernetes ConfigMap layer.
+// Use ReadConfigMapData to fetch the data from the API server.
+//
+// Parameters:
+//   - name: ConfigMap name, recorded in Sources
+//   - data: ConfigMap data keyed like environment variables
+func (l *Loader) SetConfigMap(name string, data map[string]string) {
+	l.configMapName = name
+	l.configMapData = data
+}
+
+// SetArgs sets the command-line arguments parsed for the flag layer.
+// Arguments are typically os.Args[1:], so they may hold the application's
+// own flags: flags that name no configuration field, including -h, are
+// ignored along with non-flag arguments. Parsing stops at "--".
+//
+// Parameters:
+//   - args: Command-line arguments without the program name
+func (l *Loader) SetArgs(args []string) {
+	l.args = args
+}
+
+// Load populates cfg from all layers.
+//
+// Parameters:
+//   - cfg: Pointer to a struct with `envconfig` tags, such as *Config or a
+//     service config embedding Config
+//
+// Returns:
+//   - error: Returns error if a file cannot be read or parsed, a value cannot
+//     be converted, flags are invalid, or a `required` field is left unset
+func (l *Loader) Load(cfg interface{}) error {
+	fields, err := collectFields(cfg)
+	if err != nil {
+		return err
+	}
+
+	sources := make(map[string]ValueSource, len(fields))
+
+	for _, f := range fields {
+		sources[f.Name] = ValueSource{Source: SourceDefault}
+		def, ok := f.Field.Tag.Lookup("default")
+		if !ok {
+			continue
+		}
+		if err := setFieldFromString(f.Value, def); err != nil {
+			return fmt.Errorf("default for field %s (%s): %w", f.Name, f.Key, err)
+		}
+	}
+
+	for _, path := range l.files {
+		values, err := readConfigFile(path)
+		if err != nil {
+			return err
+		}
+		if err := applyLayer(fields, values, ValueSource{Source: SourceFile, Origin: path}, sources); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range fields {
+		raw, ok := os.LookupEnv(f.Key)
+		if !ok {
+			continue
+		}
+		if err := setFieldFromString(f.Value, raw); err != nil {
+			return fmt.Errorf("env %s for field %s: %w", f.Key, f.Name, err)
+		}
+		sources[f.Name] = ValueSource{Source: SourceEnv, Key: f.Key}
+	}
+
+	if l.configMapData != nil {
+		layer := ValueSource{Source: SourceConfigMap, Origin: l.configMapName}
+		if err := applyLayer(fields, l.configMapData, layer, sources); err != nil {
+			return err
+		}
+	}
+
+	if err := applyFlags(fields, l.args, sources); err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if f.Field.Tag.Get("required") == "true" && sources[f.Name].Source == SourceDefault && f.Value.IsZero() {
+			return fmt.Errorf("required key %s missing value", f.Key)
+		}
+	}
+
+	l.sources = sources
+
+	log.Debug("Configuration loaded",
+		log.Field{Key: "files", Value: l.files},
+		log.Field{Key: "configmap", Value: l.configMapName},
+		log.Field{Key: "flags", Value: len(l.args)})
+	return nil
+}
+
+// Sources returns where each field's effective value came from, keyed by
+// Go field name (e.g., "MetricsPort"). It is empty until Load succeeds.
+//
+// Returns:
+//   - map[string]ValueSource: A copy of the recorded sources
+func (l *Loader) Sources() map[string]ValueSource {
+	out := make(map[string]ValueSource, len(l.sources))
+	for k, v := range l.sources {
+		out[k] = v
+	}
+	return out
+}
+
+// applyLayer assigns values from a key/value layer onto fields.
+// Keys match the normalized envconfig key or the uppercased Go field name.
+func applyLayer(fields []fieldInfo, values map[string]string, layer ValueSource, sources map[string]ValueSource) error {
+	index := make(map[string]string, len(values))
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	// Sorted so that conflicting spellings of the same key resolve deterministically
+	sort.Strings(keys)
+	for _, k := range keys {
+		index[normalizeKey(k)] = k
+	}
+
+	for _, f := range fields {
+		key, ok := index[normalizeKey(f.Key)]
+		if !ok {
+			key, ok = index[strings.ToUpper(f.Name)]
+		}
+		if !ok {
+			continue
+		}
+
+		if err := setFieldFromString(f.Value, strings.TrimSpace(values[key])); err != nil {
+			return fmt.Errorf("%s key %s for field %s: %w", layer.Source, key, f.Name, err)
+		}
+
+		src := layer
+		src.Key = key
+		sources[f.Name] = src
+	}
+	return nil
+}
+
+// fieldFlag is a flag.Value that captures the raw string for a config field.
+type fieldFlag struct {
+	value  string
+	isBool bool
+}
+
+// String returns the captured value.
+func (f *fieldFlag) String() string { return f.value }
+
+// Set stores the raw flag value.
+func (f *fieldFlag) Set(s string) error {
+	f.value = s
+	return nil
+}
+
+// IsBoolFlag allows boolean fields to be set with a bare --flag.
+func (f *fieldFlag) IsBoolFlag() bool { return f.isBool }
+
+// flagName converts an envconfig key into its flag name (METRICS_PORT -> metrics-port).
+func flagName(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", "-"))
+}
+
+// configArgs returns the arguments in args that set a configuration flag,
+// with the value argument following a non-boolean flag given without '='.
+// Other flags and non-flag arguments belong to the application and are
+// dropped; scanning stops at "--".
+func configArgs(args []string, byName map[string]fieldInfo) []string {
+	var kept []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if len(arg) < 2 || arg[0] != '-' {
+			continue
+		}
+
+		name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		name, _, hasValue := strings.Cut(name, "=")
+		f, ok := byName[name]
+		if !ok {
+			continue
+		}
+		kept = append(kept, arg)
+		if !hasValue && f.Value.Kind() != reflect.Bool && i+1 < len(args) {
+			i++
+			kept = append(kept, args[i])
+		}
+	}
+	return kept
+}
+
+// applyFlags parses args and assigns every flag that was explicitly set.
+func applyFlags(fields []fieldInfo, args []string, sources map[string]ValueSource) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	byName := make(map[string]fieldInfo, len(fields))
+	for _, f := range fields {
+		name := flagName(f.Key)
+		if fs.Lookup(name) != nil {
+			continue
+		}
+		fs.Var(&fieldFlag{isBool: f.Value.Kind() == reflect.Bool}, name, f.Key)
+		byName[name] = f
+	}
+
+	if err := fs.Parse(configArgs(args, byName)); err != nil {
+		return fmt.Errorf("failed to parse configuration flags: %w", err)
+	}
+
+	var setErr error
+	fs.Visit(func(fl *flag.Flag) {
+		if setErr != nil {
+			return
+		}
+		f := byName[fl.Name]
+		if err := setFieldFromString(f.Value, fl.Value.String()); err != nil {
+			setErr = fmt.Errorf("flag --%s for field %s: %w", fl.Name, f.Name, err)
+			return
+		}
+		sources[f.Name] = ValueSource{Source: SourceFlag, Key: "--" + fl.Name}
+	})
+	return setErr
+}
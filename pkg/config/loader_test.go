@@ -0,0 +1,286 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// writeTestFile writes content to a file named name in a temporary directory.
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// TestLoader_Precedence tests that each layer overrides the ones below it.
+// This verifies the documented order: defaults < file < env < ConfigMap < flags.
+func TestLoader_Precedence(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	path := writeTestFile(t, "config.yaml", `
+service_name: file-service
+environment: staging
+log_level: warn
+metrics_port: 9100
+health_check_port: 8181
+`)
+	t.Setenv("LOG_LEVEL", "error")
+	t.Setenv("METRICS_PORT", "9200")
+	t.Setenv("HEALTH_CHECK_PORT", "8281")
+
+	loader := NewLoader()
+	loader.AddFile(path)
+	loader.SetConfigMap("app-config", map[string]string{
+		"METRICS_PORT":      "9300",
+		"HEALTH_CHECK_PORT": "8381",
+	})
+	loader.SetArgs([]string{"--metrics-port=9400"})
+
+	var cfg Config
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, 8080, cfg.BusinessHTTPPort)
+	assert.Equal(t, "staging", cfg.Environment)
+	assert.Equal(t, "error", cfg.LogLevel)
+	assert.Equal(t, 8381, cfg.HealthCheckPort)
+	assert.Equal(t, 9400, cfg.MetricsPort)
+
+	sources := loader.Sources()
+	assert.Equal(t, ValueSource{Source: SourceDefault}, sources["BusinessHTTPPort"])
+	assert.Equal(t, ValueSource{Source: SourceFile, Origin: path, Key: "environment"}, sources["Environment"])
+	assert.Equal(t, ValueSource{Source: SourceEnv, Key: "LOG_LEVEL"}, sources["LogLevel"])
+	assert.Equal(t, ValueSource{Source: SourceConfigMap, Origin: "app-config", Key: "HEALTH_CHECK_PORT"}, sources["HealthCheckPort"])
+	assert.Equal(t, ValueSource{Source: SourceFlag, Key: "--metrics-port"}, sources["MetricsPort"])
+	assert.Equal(t, "flag: --metrics-port", sources["MetricsPort"].String())
+}
+
+// TestLoader_FileFormats tests loading the same settings from YAML, JSON and TOML.
+// This verifies nested tables flatten into envconfig keys in every format.
+func TestLoader_FileFormats(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	files := map[string]string{
+		"config.yaml": "serviceName: svc\ndatabase:\n  max_open_conns: 50\nk8s_resync_period: 1m\nenable_metrics: false\n",
+		"config.json": `{"ServiceName": "svc", "database": {"max_open_conns": 50}, "k8s_resync_period": "1m", "enable_metrics": false}`,
+		"config.toml": "# service settings\nservice_name = \"svc\" # inline comment\nk8s_resync_period = '1m'\nenable_metrics = false\n\n[database]\nmax_open_conns = 5_0\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			loader := NewLoader()
+			loader.AddFile(writeTestFile(t, name, content))
+
+			var cfg Config
+			require.NoError(t, loader.Load(&cfg))
+
+			assert.Equal(t, "svc", cfg.ServiceName)
+			assert.Equal(t, 50, cfg.DatabaseMaxOpenConns)
+			assert.Equal(t, time.Minute, cfg.K8sResyncPeriod)
+			assert.False(t, cfg.EnableMetrics)
+			assert.Equal(t, SourceFile, loader.Sources()["DatabaseMaxOpenConns"].Source)
+		})
+	}
+}
+
+// TestLoader_TOMLSyntax tests TOML constructs beyond plain key/value pairs.
+// This verifies inline tables, multi-line strings and quoted keys follow the TOML spec.
+func TestLoader_TOMLSyntax(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	content := "service_name = \"\"\"\nsvc\"\"\"\n" +
+		"database = { max_open_conns = 50 }\n" +
+		"\"log.level\" = \"debug\"\n" +
+		"[k8s]\nresync_period = '1m'\n"
+
+	loader := NewLoader()
+	loader.AddFile(writeTestFile(t, "config.toml", content))
+
+	var cfg Config
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, "svc", cfg.ServiceName)
+	assert.Equal(t, 50, cfg.DatabaseMaxOpenConns)
+	assert.Equal(t, time.Minute, cfg.K8sResyncPeriod)
+	assert.Equal(t, "info", cfg.LogLevel, "A quoted key containing a dot is not a dotted key")
+}
+
+// TestLoader_LaterFileOverrides tests that files added later win.
+// This verifies file layers are applied in the order they were added.
+func TestLoader_LaterFileOverrides(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	base := writeTestFile(t, "base.json", `{"service_name": "svc", "log_level": "debug", "metrics_port": 9100}`)
+	override := writeTestFile(t, "override.toml", "metrics_port = 9200\n")
+
+	loader := NewLoader()
+	loader.AddFile(base)
+	loader.AddFile(override)
+
+	var cfg Config
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, "debug", cfg.LogLevel)
+	assert.Equal(t, 9200, cfg.MetricsPort)
+	assert.Equal(t, override, loader.Sources()["MetricsPort"].Origin)
+}
+
+// TestLoader_BoolFlag tests that boolean flags may be given without a value.
+// This verifies --flag and --flag=false forms for boolean fields.
+func TestLoader_BoolFlag(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	loader := NewLoader()
+	loader.SetArgs([]string{"--service-name", "svc", "--enable-k8s-config-watch", "--enable-metrics=false"})
+
+	var cfg Config
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, "svc", cfg.ServiceName)
+	assert.True(t, cfg.EnableK8sConfigWatch)
+	assert.False(t, cfg.EnableMetrics)
+}
+
+// TestLoader_UnknownFlags tests arguments that do not name a configuration field.
+// This verifies application flags, -h and positional arguments are ignored and "--" ends parsing.
+func TestLoader_UnknownFlags(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	loader := NewLoader()
+	loader.SetArgs([]string{
+		"--service-name=svc", "--no-such-flag", "-h", "--workers", "8", "serve",
+		"-metrics-port", "9400", "--", "--health-check-port=9500",
+	})
+
+	var cfg Config
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, "svc", cfg.ServiceName)
+	assert.Equal(t, 9400, cfg.MetricsPort)
+	assert.Equal(t, 8081, cfg.HealthCheckPort, "Flags after -- are not parsed")
+	assert.Equal(t, SourceFlag, loader.Sources()["MetricsPort"].Source)
+}
+
+// TestLoader_Errors tests the failure modes of Load.
+// This verifies missing required keys, bad files, bad values and malformed flags are reported.
+func TestLoader_Errors(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	tests := []struct {
+		name    string
+		setup   func(l *Loader)
+		wantErr string
+	}{
+		{
+			name:    "missing required",
+			setup:   func(l *Loader) {},
+			wantErr: "required key SERVICE_NAME missing value",
+		},
+		{
+			name:    "missing file",
+			setup:   func(l *Loader) { l.AddFile(filepath.Join(t.TempDir(), "absent.yaml")) },
+			wantErr: "failed to read config file",
+		},
+		{
+			name:    "unsupported format",
+			setup:   func(l *Loader) { l.AddFile(writeTestFile(t, "config.ini", "a=b")) },
+			wantErr: "unsupported config file format",
+		},
+		{
+			name:    "invalid toml",
+			setup:   func(l *Loader) { l.AddFile(writeTestFile(t, "config.toml", "ports = [1, 2]\n")) },
+			wantErr: "key ports: unsupported value type",
+		},
+		{
+			name:    "bare toml string",
+			setup:   func(l *Loader) { l.AddFile(writeTestFile(t, "config.toml", "service_name = svc\n")) },
+			wantErr: "failed to parse config file",
+		},
+		{
+			name:    "toml array of tables",
+			setup:   func(l *Loader) { l.AddFile(writeTestFile(t, "config.toml", "[[servers]]\nport = 1\n")) },
+			wantErr: "key servers: unsupported value type",
+		},
+		{
+			name: "invalid value",
+			setup: func(l *Loader) {
+				l.SetConfigMap("app-config", map[string]string{"SERVICE_NAME": "svc", "METRICS_PORT": "secret-ish"})
+			},
+			wantErr: "configmap key METRICS_PORT for field MetricsPort",
+		},
+		{
+			name:    "flag without value",
+			setup:   func(l *Loader) { l.SetArgs([]string{"--service-name=svc", "--metrics-port"}) },
+			wantErr: "failed to parse configuration flags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader()
+			tt.setup(loader)
+
+			var cfg Config
+			err := loader.Load(&cfg)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.NotContains(t, err.Error(), "secret-ish")
+		})
+	}
+}
+
+// TestLoader_EmbeddedConfig tests loading a service config that embeds Config.
+// This verifies custom fields participate in every layer alongside the common ones.
+func TestLoader_EmbeddedConfig(t *testing.T) {
+	cleanupEnv()
+	defer cleanupEnv()
+
+	type serviceConfig struct {
+		Config
+		CacheSize int `envconfig:"CACHE_SIZE" default:"16"`
+	}
+
+	loader := NewLoader()
+	loader.SetArgs([]string{"--service-name=svc", "--cache-size=64"})
+
+	var cfg serviceConfig
+	require.NoError(t, loader.Load(&cfg))
+
+	assert.Equal(t, "svc", cfg.ServiceName)
+	assert.Equal(t, 64, cfg.CacheSize)
+	assert.Equal(t, SourceFlag, loader.Sources()["CacheSize"].Source)
+}
+
+// TestReadConfigMapData tests fetching ConfigMap data for the loader.
+// This verifies the data is returned for existing ConfigMaps and errors otherwise.
+func TestReadConfigMapData(t *testing.T) {
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "default"},
+		Data:       map[string]string{"LOG_LEVEL": "debug"},
+	})
+
+	data, err := ReadConfigMapData(context.Background(), client, "default", "app-config")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, data)
+
+	_, err = ReadConfigMapData(context.Background(), client, "default", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get configmap default/missing")
+}
@@ -0,0 +1,165 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// ChangeFunc is invoked after the global configuration has been replaced.
+// The old and new values are immutable snapshots; callbacks must not modify them.
+//
+// Parameters:
+//   - old: The configuration before the change (may be nil)
+//   - new: The configuration after the change (may be nil)
+type ChangeFunc func(old, new *Config)
+
+// subscription is a registered change callback.
+// An empty field means the callback fires on every change.
+type subscription struct {
+	id    uint64
+	field string
+	fn    ChangeFunc
+}
+
+var (
+	// subscriptions holds registered callbacks in registration order.
+	subscriptions []*subscription
+
+	// nextSubscriptionID is the identifier assigned to the next subscription.
+	nextSubscriptionID uint64
+
+	// subscriptionMutex protects subscriptions and nextSubscriptionID.
+	subscriptionMutex sync.Mutex
+
+	// notifyMutex serializes configuration replacement and notification so that
+	// subscribers observe changes in the same order they were applied.
+	notifyMutex sync.Mutex
+)
+
+// Subscribe registers a callback that is invoked every time the global
+// configuration is replaced via Set or Update.
+//
+// Callbacks run synchronously on the goroutine that changed the configuration,
+// in registration order, after the new configuration is visible through Get.
+// A panicking callback is recovered and logged without affecting the others.
+// Callbacks must not call Set or Update themselves.
+//
+// The subscription is removed when ctx is canceled.
+//
+// Parameters:
+//   - ctx: Context controlling the lifetime of the subscription
+//   - fn: Callback receiving the previous and current configuration
+//
+// Example:
+//
+//	config.Subscribe(ctx, func(old, new *config.Config) {
+//	    log.Info("Configuration changed")
+//	})
+func Subscribe(ctx context.Context, fn ChangeFunc) {
+	addSubscription(ctx, "", fn)
+}
+
+// SubscribeField registers a callback that is invoked only when the named
+// Config field changes value. The field is identified by its Go name
+// (e.g., "LogLevel"), not its environment variable key.
+//
+// Ordering, panic handling and cancellation follow the same rules as Subscribe.
+//
+// Parameters:
+//   - ctx: Context controlling the lifetime of the subscription
+//   - field: Go field name of Config to watch
+//   - fn: Callback receiving the previous and current configuration
+//
+// Returns:
+//   - error: Returns error if Config has no field with the given name
+//
+// Example:
+//
+//	err := config.SubscribeField(ctx, "LogLevel", func(old, new *config.Config) {
+//	    _ = log.Init(new.LogLevel, new.LogFormat)
+//	})
+func SubscribeField(ctx context.Context, field string, fn ChangeFunc) error {
+	if _, ok := reflect.TypeOf(Config{}).FieldByName(field); !ok {
+		return fmt.Errorf("unknown config field: %s", field)
+	}
+	addSubscription(ctx, field, fn)
+	return nil
+}
+
+// addSubscription stores the callback and arranges for its removal on ctx cancellation.
+func addSubscription(ctx context.Context, field string, fn ChangeFunc) {
+	if fn == nil || ctx.Err() != nil {
+		return
+	}
+
+	subscriptionMutex.Lock()
+	nextSubscriptionID++
+	sub := &subscription{id: nextSubscriptionID, field: field, fn: fn}
+	subscriptions = append(subscriptions, sub)
+	subscriptionMutex.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		removeSubscription(sub.id)
+	})
+}
+
+// removeSubscription deletes the subscription with the given id, preserving order.
+func removeSubscription(id uint64) {
+	subscriptionMutex.Lock()
+	defer subscriptionMutex.Unlock()
+
+	for i, sub := range subscriptions {
+		if sub.id == id {
+			subscriptions = append(subscriptions[:i:i], subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+// notifySubscribers invokes all matching subscriptions for a configuration change.
+// Callers must hold notifyMutex and must not hold configMutex.
+func notifySubscribers(old, new *Config) {
+	if old == new {
+		return
+	}
+
+	subscriptionMutex.Lock()
+	subs := make([]*subscription, len(subscriptions))
+	copy(subs, subscriptions)
+	subscriptionMutex.Unlock()
+
+	for _, sub := range subs {
+		if sub.field != "" && !fieldChanged(old, new, sub.field) {
+			continue
+		}
+		invokeSubscriber(sub, old, new)
+	}
+}
+
+// invokeSubscriber runs a single callback, recovering from panics.
+func invokeSubscriber(sub *subscription, old, new *Config) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("Config change subscriber panicked",
+				log.Field{Key: "field", Value: sub.field},
+				log.Field{Key: "panic", Value: r})
+		}
+	}()
+	sub.fn(old, new)
+}
+
+// fieldChanged reports whether the named field differs between old and new.
+// A transition between nil and non-nil always counts as a change.
+func fieldChanged(old, new *Config, field string) bool {
+	if old == nil || new == nil {
+		return old != new
+	}
+	oldValue := reflect.ValueOf(old).Elem().FieldByName(field)
+	newValue := reflect.ValueOf(new).Elem().FieldByName(field)
+	return oldValue.Interface() != newValue.Interface()
+}
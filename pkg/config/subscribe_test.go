@@ -0,0 +1,179 @@
+package config
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubscribe_NotifiedOnSet tests that subscribers observe Set calls.
+// This verifies old and new snapshots are passed to the callback.
+func TestSubscribe_NotifiedOnSet(t *testing.T) {
+	Set(nil)
+	ctx := testSubscriptionContext(t)
+
+	var gotOld, gotNew *Config
+	Subscribe(ctx, func(old, new *Config) {
+		gotOld, gotNew = old, new
+	})
+
+	cfg := newValidTestConfig()
+	Set(cfg)
+
+	assert.Nil(t, gotOld)
+	assert.Same(t, cfg, gotNew)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestSubscribe_NotifiedOnUpdate tests that subscribers observe Update calls.
+// This verifies the callback sees the already-swapped configuration.
+func TestSubscribe_NotifiedOnUpdate(t *testing.T) {
+	Set(newValidTestConfig())
+	ctx := testSubscriptionContext(t)
+
+	var seen string
+	Subscribe(ctx, func(old, new *Config) {
+		if new == nil {
+			return
+		}
+		assert.Equal(t, "info", old.LogLevel)
+		seen = Get().LogLevel
+	})
+
+	require.NoError(t, Update(map[string]string{"LOG_LEVEL": "debug"}))
+
+	assert.Equal(t, "debug", seen)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestSubscribe_Order tests that callbacks run in registration order.
+// This verifies the sequential notification guarantee.
+func TestSubscribe_Order(t *testing.T) {
+	Set(nil)
+	ctx := testSubscriptionContext(t)
+
+	var order []int
+	for i := 1; i <= 3; i++ {
+		n := i
+		Subscribe(ctx, func(old, new *Config) {
+			order = append(order, n)
+		})
+	}
+
+	Set(newValidTestConfig())
+
+	assert.Equal(t, []int{1, 2, 3}, order)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestSubscribe_ContextCancellation tests that canceled subscriptions stop firing.
+// This verifies subscriptions are removed when their context ends.
+func TestSubscribe_ContextCancellation(t *testing.T) {
+	Set(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var mu sync.Mutex
+	calls := 0
+	Subscribe(ctx, func(old, new *Config) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+	})
+
+	Set(newValidTestConfig())
+	cancel()
+
+	assert.Eventually(t, func() bool {
+		subscriptionMutex.Lock()
+		defer subscriptionMutex.Unlock()
+		return len(subscriptions) == 0
+	}, time.Second, time.Millisecond)
+
+	Set(newValidTestConfig())
+
+	mu.Lock()
+	assert.Equal(t, 1, calls)
+	mu.Unlock()
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestSubscribeField_OnlyOnFieldChange tests per-field watchers.
+// This verifies callbacks fire only when the watched field changes.
+func TestSubscribeField_OnlyOnFieldChange(t *testing.T) {
+	Set(newValidTestConfig())
+	ctx := testSubscriptionContext(t)
+
+	calls := 0
+	err := SubscribeField(ctx, "LogLevel", func(old, new *Config) {
+		if new == nil {
+			return
+		}
+		calls++
+		assert.Equal(t, "warn", new.LogLevel)
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, Update(map[string]string{"LOG_FORMAT": "console"}))
+	assert.Equal(t, 0, calls, "Unrelated field change must not notify")
+
+	require.NoError(t, Update(map[string]string{"LOG_LEVEL": "warn"}))
+	assert.Equal(t, 1, calls)
+
+	// Cleanup
+	Set(nil)
+}
+
+// TestSubscribeField_UnknownField tests error handling for invalid field names.
+// This is an isolated method test with no external dependencies.
+func TestSubscribeField_UnknownField(t *testing.T) {
+	err := SubscribeField(context.Background(), "NoSuchField", func(old, new *Config) {})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown config field")
+}
+
+// TestSubscribe_PanicRecovered tests that a panicking callback does not break others.
+// This verifies subscriber isolation.
+func TestSubscribe_PanicRecovered(t *testing.T) {
+	Set(nil)
+	ctx := testSubscriptionContext(t)
+
+	called := false
+	Subscribe(ctx, func(old, new *Config) { panic("boom") })
+	Subscribe(ctx, func(old, new *Config) { called = true })
+
+	assert.NotPanics(t, func() {
+		Set(newValidTestConfig())
+	})
+	assert.True(t, called)
+
+	// Cleanup
+	Set(nil)
+}
+
+// testSubscriptionContext returns a context whose subscriptions are removed
+// before the next test runs. Helper function for test isolation.
+func testSubscriptionContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		require.Eventually(t, func() bool {
+			subscriptionMutex.Lock()
+			defer subscriptionMutex.Unlock()
+			return len(subscriptions) == 0
+		}, time.Second, time.Millisecond)
+	})
+	return ctx
+}
@@ -0,0 +1,328 @@
+// Package config provides unified configuration management for EggyByte services.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Validator is implemented by configuration structs that need rules beyond
+// what `validate` struct tags can express, such as cross-field checks.
+// Config implements Validator, so a service config embedding Config inherits
+// its rules unless it declares its own Validate method; in that case it
+// should call the embedded Config.Validate itself.
+//
+// Example:
+//
+//	func (c *MyServiceConfig) Validate() error {
+//	    var errs config.ValidationErrors
+//	    if err := c.Config.Validate(); err != nil {
+//	        errs = append(errs, err)
+//	    }
+//	    if c.CacheSize > 0 && c.CacheTTL == 0 {
+//	        errs = append(errs, fmt.Errorf("cache TTL required when cache is enabled"))
+//	    }
+//	    return errs.ErrOrNil()
+//	}
+type Validator interface {
+	// Validate returns an error describing every violated rule, or nil.
+	Validate() error
+}
+
+// FieldError describes a struct field that violates a validation rule.
+// Messages produced for `validate` tag rules never include the field value,
+// since values may be secrets.
+type FieldError struct {
+	// Field is the Go field name (e.g., "MetricsPort").
+	Field string
+
+	// Key is the envconfig key (e.g., "METRICS_PORT").
+	Key string
+
+	// Rule is the violated rule name (e.g., "max").
+	Rule string
+
+	// Message describes the violation (e.g., "must be at most 65535").
+	Message string
+}
+
+// Error returns the violation as "KEY: message".
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+// ValidationErrors collects every violation found by a validation pass.
+// It supports errors.Is and errors.As through Unwrap.
+type ValidationErrors []error
+
+// Error joins all violations into a single message.
+func (v ValidationErrors) Error() string {
+	if len(v) == 1 {
+		return v[0].Error()
+	}
+
+	msgs := make([]string, len(v))
+	for i, err := range v {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d validation errors: %s", len(v), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual violations.
+func (v ValidationErrors) Unwrap() []error {
+	return v
+}
+
+// ErrOrNil returns nil if there are no violations, otherwise v.
+// Use it when returning a ValidationErrors built up with append, since a
+// nil ValidationErrors stored in an error interface is not a nil error.
+//
+// Returns:
+//   - error: nil or the collected violations
+func (v ValidationErrors) ErrOrNil() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// add appends err, flattening nested ValidationErrors.
+func (v *ValidationErrors) add(err error) {
+	if err == nil {
+		return
+	}
+	var nested ValidationErrors
+	if errors.As(err, &nested) {
+		*v = append(*v, nested...)
+		return
+	}
+	*v = append(*v, err)
+}
+
+// Validate checks a configuration struct against its `validate` struct tags
+// and, if it implements Validator, its custom rules. All violations are
+// returned together as ValidationErrors.
+//
+// Rules are comma-separated in the `validate` tag:
+//   - required: value must not be the zero value
+//   - omitempty: skip all other rules when the value is the zero value
+//   - min=N, max=N: bounds for numbers, string length, or durations (min=1s)
+//   - oneof=a b c: value must be one of the space-separated options
+//   - url: string must be an absolute URL with scheme and host
+//   - duration: string must parse with time.ParseDuration
+//   - regex=PATTERN: string must match PATTERN; must be the last rule since
+//     the pattern may contain commas
+//
+// Parameters:
+//   - cfg: Pointer to a configuration struct, such as a service config
+//     embedding Config
+//
+// Returns:
+//   - error: ValidationErrors with every violation, or nil if valid
+//
+// Example:
+//
+//	type MyServiceConfig struct {
+//	    config.Config
+//	    UpstreamURL string        `envconfig:"UPSTREAM_URL" validate:"required,url"`
+//	    Workers     int           `envconfig:"WORKERS" default:"4" validate:"min=1,max=64"`
+//	    Mode        string        `envconfig:"MODE" default:"fast" validate:"oneof=fast safe"`
+//	    Timeout     time.Duration `envconfig:"TIMEOUT" default:"5s" validate:"min=100ms,max=1m"`
+//	}
+//
+//	var cfg MyServiceConfig
+//	config.MustReadFromEnv(&cfg)
+//	if err := config.Validate(&cfg); err != nil {
+//	    log.Fatal("Invalid configuration", log.Field{Key: "error", Value: err})
+//	}
+func Validate(cfg interface{}) error {
+	fields, err := collectFields(cfg)
+	if err != nil {
+		return err
+	}
+
+	var errs ValidationErrors
+	for _, f := range fields {
+		tag, ok := f.Field.Tag.Lookup("validate")
+		if !ok || tag == "" {
+			continue
+		}
+		for _, fieldErr := range validateField(f, tag) {
+			errs = append(errs, fieldErr)
+		}
+	}
+
+	if v, ok := cfg.(Validator); ok {
+		errs.add(v.Validate())
+	}
+
+	return errs.ErrOrNil()
+}
+
+// validationRule is a single parsed rule from a `validate` tag.
+type validationRule struct {
+	name  string
+	param string
+}
+
+// parseRules splits a `validate` tag into rules. A regex rule consumes the
+// remainder of the tag.
+func parseRules(tag string) []validationRule {
+	var rules []validationRule
+	for tag != "" {
+		if strings.HasPrefix(tag, "regex=") {
+			rules = append(rules, validationRule{name: "regex", param: strings.TrimPrefix(tag, "regex=")})
+			break
+		}
+
+		part, rest, _ := strings.Cut(tag, ",")
+		tag = rest
+
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, validationRule{name: name, param: param})
+	}
+	return rules
+}
+
+// validateField applies the rules in tag to a single field.
+func validateField(f fieldInfo, tag string) []*FieldError {
+	rules := parseRules(tag)
+
+	if f.Value.IsZero() {
+		for _, r := range rules {
+			if r.name == "omitempty" {
+				return nil
+			}
+		}
+	}
+
+	var errs []*FieldError
+	for _, r := range rules {
+		if r.name == "omitempty" {
+			continue
+		}
+		if msg := checkRule(f.Value, r); msg != "" {
+			errs = append(errs, &FieldError{Field: f.Name, Key: f.Key, Rule: r.name, Message: msg})
+		}
+	}
+	return errs
+}
+
+// checkRule evaluates one rule and returns a violation message, or "" if satisfied.
+func checkRule(v reflect.Value, r validationRule) string {
+	switch r.name {
+	case "required":
+		if v.IsZero() {
+			return "is required"
+		}
+	case "min", "max":
+		return checkBound(v, r)
+	case "oneof":
+		options := strings.Fields(r.param)
+		actual := fmt.Sprint(v.Interface())
+		for _, opt := range options {
+			if actual == opt {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of: %s", strings.Join(options, ", "))
+	case "url":
+		if v.Kind() != reflect.String {
+			return "url rule requires a string field"
+		}
+		u, err := url.Parse(v.String())
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "must be a valid URL"
+		}
+	case "duration":
+		if v.Kind() != reflect.String {
+			return "duration rule requires a string field"
+		}
+		if _, err := time.ParseDuration(v.String()); err != nil {
+			return "must be a valid duration"
+		}
+	case "regex":
+		if v.Kind() != reflect.String {
+			return "regex rule requires a string field"
+		}
+		re, err := compileRegex(r.param)
+		if err != nil {
+			return fmt.Sprintf("has invalid regex rule: %v", err)
+		}
+		if !re.MatchString(v.String()) {
+			return fmt.Sprintf("must match %s", r.param)
+		}
+	default:
+		return fmt.Sprintf("has unknown validation rule %q", r.name)
+	}
+	return ""
+}
+
+// checkBound evaluates a min or max rule for numbers, durations and string lengths.
+func checkBound(v reflect.Value, r validationRule) string {
+	var (
+		actual, bound float64
+		err           error
+		unit          string
+	)
+
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		var d time.Duration
+		d, err = time.ParseDuration(r.param)
+		actual, bound = float64(v.Int()), float64(d)
+	case v.Kind() == reflect.String:
+		var n int
+		n, err = strconv.Atoi(r.param)
+		actual, bound, unit = float64(len(v.String())), float64(n), " characters"
+	case v.CanInt():
+		actual = float64(v.Int())
+		bound, err = strconv.ParseFloat(r.param, 64)
+	case v.CanUint():
+		actual = float64(v.Uint())
+		bound, err = strconv.ParseFloat(r.param, 64)
+	case v.CanFloat():
+		actual = v.Float()
+		bound, err = strconv.ParseFloat(r.param, 64)
+	default:
+		return fmt.Sprintf("%s rule does not support %s fields", r.name, v.Type())
+	}
+	if err != nil {
+		return fmt.Sprintf("has invalid %s rule %q", r.name, r.param)
+	}
+
+	if r.name == "min" && actual < bound {
+		return fmt.Sprintf("must be at least %s%s", r.param, unit)
+	}
+	if r.name == "max" && actual > bound {
+		return fmt.Sprintf("must be at most %s%s", r.param, unit)
+	}
+	return ""
+}
+
+// regexCache holds compiled `regex` rule patterns keyed by pattern.
+var regexCache sync.Map
+
+// compileRegex compiles pattern once and caches the result.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,205 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// taggedConfig is a service config exercising every validation rule.
+type taggedConfig struct {
+	Config
+	UpstreamURL string        `envconfig:"UPSTREAM_URL" validate:"required,url"`
+	Workers     int           `envconfig:"WORKERS" validate:"min=1,max=64"`
+	Ratio       float64       `envconfig:"RATIO" validate:"max=1"`
+	Mode        string        `envconfig:"MODE" validate:"oneof=fast safe"`
+	Timeout     time.Duration `envconfig:"TIMEOUT" validate:"min=100ms,max=1m"`
+	Interval    string        `envconfig:"INTERVAL" validate:"omitempty,duration"`
+	Tenant      string        `envconfig:"TENANT" validate:"min=3,regex=^[a-z]{1,8}(-[a-z]+)?$"`
+	Region      string        `envconfig:"REGION" validate:"omitempty,oneof=eu us"`
+	APIToken    string        `envconfig:"API_TOKEN" secret:"true" validate:"regex=^tok_"`
+}
+
+// newValidTaggedConfig returns a taggedConfig that satisfies every rule.
+func newValidTaggedConfig() *taggedConfig {
+	return &taggedConfig{
+		Config:      *newValidTestConfig(),
+		UpstreamURL: "https://upstream.internal:8443/api",
+		Workers:     4,
+		Ratio:       0.5,
+		Mode:        "fast",
+		Timeout:     5 * time.Second,
+		Tenant:      "acme-eu",
+		APIToken:    "tok_abc",
+	}
+}
+
+// TestValidate_ValidStruct tests that a compliant struct passes.
+// This verifies no rule reports a false positive.
+func TestValidate_ValidStruct(t *testing.T) {
+	assert.NoError(t, Validate(newValidTaggedConfig()))
+}
+
+// TestValidate_ReportsAllViolations tests that every violation is returned at once.
+// This verifies each rule type and that the embedded Config rules also run.
+func TestValidate_ReportsAllViolations(t *testing.T) {
+	cfg := &taggedConfig{
+		Config:      *newValidTestConfig(),
+		UpstreamURL: "not a url",
+		Workers:     100,
+		Ratio:       1.5,
+		Mode:        "turbo",
+		Timeout:     time.Millisecond,
+		Interval:    "often",
+		Tenant:      "AB",
+		APIToken:    "hunter2",
+	}
+	cfg.LogLevel = "loud"
+
+	err := Validate(cfg)
+	require.Error(t, err)
+
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+
+	var rules []string
+	for _, e := range verrs {
+		var fe *FieldError
+		if errors.As(e, &fe) {
+			rules = append(rules, fe.Field+":"+fe.Rule)
+		}
+	}
+	assert.Equal(t, []string{
+		"UpstreamURL:url",
+		"Workers:max",
+		"Ratio:max",
+		"Mode:oneof",
+		"Timeout:min",
+		"Interval:duration",
+		"Tenant:min",
+		"Tenant:regex",
+		"APIToken:regex",
+		"LogLevel:oneof", // From the embedded Config's Validate
+	}, rules)
+	assert.Len(t, verrs, len(rules), "every violation should be reported as a FieldError")
+
+	assert.Contains(t, err.Error(), "invalid log level")
+	assert.Contains(t, err.Error(), "WORKERS: must be at most 64")
+	assert.Contains(t, err.Error(), "TENANT: must be at least 3 characters")
+	assert.Contains(t, err.Error(), "MODE: must be one of: fast, safe")
+	assert.NotContains(t, err.Error(), "hunter2")
+}
+
+// TestValidate_RequiredAndOmitempty tests zero-value handling.
+// This verifies required rejects zero values and omitempty skips other rules.
+func TestValidate_RequiredAndOmitempty(t *testing.T) {
+	cfg := newValidTaggedConfig()
+	cfg.UpstreamURL = ""
+	cfg.Region = ""
+
+	err := Validate(cfg)
+
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+	assert.Len(t, verrs, 2)
+	assert.Contains(t, err.Error(), "UPSTREAM_URL: is required")
+	assert.Contains(t, err.Error(), "UPSTREAM_URL: must be a valid URL")
+}
+
+// customValidated is a config with a cross-field Validator implementation.
+type customValidated struct {
+	Min int `envconfig:"MIN" validate:"min=0"`
+	Max int `envconfig:"MAX" validate:"min=0"`
+}
+
+// Validate implements Validator.
+func (c *customValidated) Validate() error {
+	if c.Min > c.Max {
+		return fmt.Errorf("MIN must not exceed MAX")
+	}
+	return nil
+}
+
+// TestValidate_CustomValidator tests that Validator implementations are invoked.
+// This verifies tag and custom violations are combined.
+func TestValidate_CustomValidator(t *testing.T) {
+	assert.NoError(t, Validate(&customValidated{Min: 1, Max: 2}))
+
+	err := Validate(&customValidated{Min: -1, Max: -2})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "3 validation errors")
+	assert.Contains(t, err.Error(), "MIN: must be at least 0")
+	assert.Contains(t, err.Error(), "MIN must not exceed MAX")
+}
+
+// TestValidate_InvalidRules tests reporting of malformed tags.
+// This verifies programming errors in tags surface as violations rather than panics.
+func TestValidate_InvalidRules(t *testing.T) {
+	cfg := &struct {
+		A int    `envconfig:"A" validate:"min=abc"`
+		B string `envconfig:"B" validate:"between=1"`
+		C bool   `envconfig:"C" validate:"max=1"`
+		D string `envconfig:"D" validate:"regex=("`
+	}{}
+
+	err := Validate(cfg)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `A: has invalid min rule "abc"`)
+	assert.Contains(t, err.Error(), `B: has unknown validation rule "between"`)
+	assert.Contains(t, err.Error(), "C: max rule does not support bool fields")
+	assert.Contains(t, err.Error(), "D: has invalid regex rule")
+}
+
+// TestValidate_NonPointer tests argument checking.
+// This verifies non-pointer values are rejected.
+func TestValidate_NonPointer(t *testing.T) {
+	err := Validate(Config{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "non-nil struct pointer")
+}
+
+// TestValidateConfig_ReportsAllViolations tests aggregation in ValidateConfig.
+// This verifies every violation is returned instead of only the first.
+func TestValidateConfig_ReportsAllViolations(t *testing.T) {
+	cfg := &Config{
+		BusinessHTTPPort:     8080,
+		BusinessGRPCPort:     8080,
+		HealthCheckPort:      0,
+		MetricsPort:          9091,
+		LogLevel:             "loud",
+		LogFormat:            "json",
+		EnableBusinessHTTP:   true,
+		EnableBusinessGRPC:   true,
+		EnableHealthCheck:    true,
+		EnableK8sConfigWatch: true,
+	}
+
+	err := ValidateConfig(cfg)
+
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+	assert.Len(t, verrs, 6)
+	assert.Contains(t, err.Error(), "service name cannot be empty")
+	assert.Contains(t, err.Error(), "health check port must be between 1 and 65535")
+	assert.Contains(t, err.Error(), "business HTTP and business gRPC ports cannot be the same")
+	assert.Contains(t, err.Error(), "invalid log level")
+	assert.Contains(t, err.Error(), "kubernetes namespace required")
+	assert.Contains(t, err.Error(), "kubernetes configmap name required")
+}
+
+// TestValidationErrors_ErrOrNil tests conversion of an empty collection.
+// This verifies an empty ValidationErrors becomes a nil error.
+func TestValidationErrors_ErrOrNil(t *testing.T) {
+	var errs ValidationErrors
+	assert.NoError(t, errs.ErrOrNil())
+
+	errs = append(errs, fmt.Errorf("boom"))
+	assert.EqualError(t, errs.ErrOrNil(), "boom")
+}
@@ -0,0 +1,669 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/config"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/monitoring"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/server"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
+)
+
+// appState is the lifecycle phase of an App.
+type appState int
+
+const (
+	appCreated appState = iota
+	appBuilding
+	appBuilt
+	appRunning
+	appStopped
+)
+
+// App is an EggyByte service assembled from configuration and options.
+// Its lifecycle is split into phases so tests and embedding programs can
+// drive it step by step and inspect it in between:
+//   - Build validates the configuration and creates all servers and services
+//   - Run starts them and blocks until shutdown
+//   - Shutdown stops a running App and waits for Run to return
+//
+// After Build, Services, Ports and Features describe what will run, and the
+// built-in servers can be configured through the accessor methods.
+// BootstrapWithOptions is Build followed by Run.
+//
+// Thread Safety: Build and the configuration methods should be called from
+// a single goroutine. Shutdown may be called concurrently with Run.
+//
+// Example:
+//
+//	app := core.NewApp(cfg, core.WithServices(worker))
+//	if err := app.Build(ctx); err != nil {
+//	    log.Fatal("Build failed", log.Field{Key: "error", Value: err})
+//	}
+//	app.HTTPServer().HandleFunc("/api/v1/users", userHandler)
+//	if err := app.Run(ctx); err != nil {
+//	    log.Fatal("Service failed", log.Field{Key: "error", Value: err})
+//	}
+type App struct {
+	// cfg is the service configuration
+	cfg *config.Config
+
+	// opts holds the options the App was created with
+	opts *options
+
+	// launcher manages the lifecycle of all registered services
+	launcher *service.Launcher
+
+	// httpServer is the business HTTP server, nil if disabled
+	httpServer *server.HTTPServer
+
+	// grpcServer is the business gRPC server, nil if disabled
+	grpcServer *server.GRPCServer
+
+	// healthService serves health check endpoints, nil if disabled
+	healthService *monitoring.HealthService
+
+	// metricsService serves Prometheus metrics, nil if disabled
+	metricsService *monitoring.MetricsService
+
+	// configWatchers are the Kubernetes config watchers, empty if disabled
+	configWatchers []*config.K8sConfigWatcher
+
+	// mu protects the lifecycle fields below
+	mu sync.Mutex
+
+	// state is the current lifecycle phase
+	state appState
+
+	// stopWatching removes the configuration change subscriptions made by Build
+	stopWatching context.CancelFunc
+
+	// building is set while Build runs
+	building bool
+
+	// buildInits names the initializers added through the App while Build
+	// runs, so a failed Build can unregister them
+	buildInits []string
+
+	// cancelRun cancels the context of an in-progress Run
+	cancelRun context.CancelFunc
+
+	// done is closed when an in-progress Run returns
+	done chan struct{}
+}
+
+// Ports lists the listening ports of the enabled servers.
+// The port of a disabled server is 0.
+type Ports struct {
+	BusinessHTTP int `json:"business_http"`
+	BusinessGRPC int `json:"business_grpc"`
+	HealthCheck  int `json:"health_check"`
+	Metrics      int `json:"metrics"`
+}
+
+// Features lists which optional components are enabled.
+type Features struct {
+	BusinessHTTP   bool `json:"business_http"`
+	BusinessGRPC   bool `json:"business_grpc"`
+	HealthCheck    bool `json:"health_check"`
+	Metrics        bool `json:"metrics"`
+	Configz        bool `json:"configz"`
+	K8sConfigWatch bool `json:"k8s_config_watch"`
+	Database       bool `json:"database"`
+}
+
+// NewApp creates an application for cfg. Nothing is validated or created
+// until Build is called.
+//
+// Parameters:
+//   - cfg: Service configuration loaded from environment variables
+//   - opts: Options such as WithServices and WithSetup
+//
+// Returns:
+//   - *App: Application ready to be built
+func NewApp(cfg *config.Config, opts ...Option) *App {
+	app := newApp(cfg, service.NewLauncher())
+	app.opts = newOptions(opts)
+	return app
+}
+
+// newApp creates an empty application handle for cfg whose services are
+// registered with launcher.
+func newApp(cfg *config.Config, launcher *service.Launcher) *App {
+	return &App{
+		cfg:      cfg,
+		opts:     &options{},
+		launcher: launcher,
+	}
+}
+
+// Build validates the configuration, initializes logging, publishes the
+// configuration globally and registers every initializer, server and
+// service, then runs the WithSetup functions. No service is started.
+//
+// If a step fails, Build undoes what it has done so far: the previous
+// global configuration is restored, the configuration change subscriptions
+// are removed, and the services and initializers registered since Build
+// began, including those added by WithSetup functions, are unregistered.
+// The App can then be built again. Once Build has succeeded, further calls
+// fail. Shutdown called while building, e.g. from a WithSetup function,
+// makes Build fail and roll back; Run called while building fails.
+//
+// Parameters:
+//   - ctx: Context bounding the configuration change subscriptions; they
+//     are also removed when Run returns or Shutdown is called
+//
+// Returns:
+//   - error: Returns error if the App was already built or any step fails
+func (a *App) Build(ctx context.Context) (err error) {
+	// The lock is not held while building, so WithSetup functions may call
+	// any App method; the appBuilding state keeps Run and Build out
+	a.mu.Lock()
+	switch a.state {
+	case appBuilding:
+		a.mu.Unlock()
+		return fmt.Errorf("application build already in progress")
+	case appBuilt:
+		a.mu.Unlock()
+		return fmt.Errorf("application already built")
+	case appRunning, appStopped:
+		a.mu.Unlock()
+		return fmt.Errorf("application already run: create a new App to build again")
+	}
+	a.state = appBuilding
+	a.mu.Unlock()
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		a.mu.Lock()
+		if a.state == appBuilding {
+			a.state = appCreated
+		}
+		a.mu.Unlock()
+	}()
+
+	cfg := a.cfg
+
+	// Phase 1: Validate configuration before starting anything, then initialize logging
+	if err := config.ValidateConfig(cfg); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	if err := initializeLogging(cfg); err != nil {
+		return fmt.Errorf("failed to initialize logging: %w", err)
+	}
+
+	log.Info("Starting service bootstrap",
+		log.Field{Key: "service", Value: cfg.ServiceName},
+		log.Field{Key: "environment", Value: cfg.Environment})
+
+	// From here on, undo every change if a later phase fails
+	rollback := a.beginBuild()
+	defer func() {
+		a.building = false
+		if err != nil {
+			rollback()
+		}
+	}()
+
+	// Phase 2: Set global configuration and react to live changes
+	config.Set(cfg)
+
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	a.stopWatching = stopWatching
+	if err := watchConfigChanges(watchCtx); err != nil {
+		return err
+	}
+
+	// Phase 3: Configure service launcher
+	a.launcher.SetLogger(log.Default())
+	a.launcher.SetDrainPeriod(cfg.ShutdownDrainPeriod)
+	a.launcher.SetReloadFunc(a.Reload)
+
+	// Phase 4: Create and register business servers
+	if err := a.registerBusinessServers(); err != nil {
+		return err
+	}
+
+	// Phase 5: Register infrastructure services
+	if err := a.registerInfraServices(); err != nil {
+		return err
+	}
+
+	// Phase 6: Register additional business services and the job scheduler
+	a.launcher.AddService(a.opts.services...)
+	if err := a.registerScheduler(); err != nil {
+		return err
+	}
+
+	// Phase 7: Let the application configure the assembled servers
+	for _, setup := range a.opts.setups {
+		if err := setup(a); err != nil {
+			return fmt.Errorf("application setup failed: %w", err)
+		}
+	}
+
+	// Phase 8: Register infrastructure initializers and shutdown hooks last,
+	// as hooks cannot be unregistered
+	if err := registerInitializers(a.launcher, cfg); err != nil {
+		return err
+	}
+
+	// Flush logs after everything else has stopped
+	a.launcher.OnStopped(syncLogger)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.state == appStopped {
+		return fmt.Errorf("application shut down during build")
+	}
+	a.state = appBuilt
+	return nil
+}
+
+// beginBuild records the state Build starts from and returns a function
+// restoring it. It is called by Build only.
+func (a *App) beginBuild() func() {
+	previous := config.Get()
+	existing := make(map[string]bool)
+	for _, status := range a.launcher.Status() {
+		existing[status.Name] = true
+	}
+	a.building = true
+	a.buildInits = nil
+
+	return func() {
+		if a.stopWatching != nil {
+			a.stopWatching()
+			a.stopWatching = nil
+		}
+		config.Set(previous)
+
+		// Unregister in reverse order so dependents go before their dependencies
+		statuses := a.launcher.Status()
+		for i := len(statuses) - 1; i >= 0; i-- {
+			name := statuses[i].Name
+			if existing[name] {
+				continue
+			}
+			if err := a.launcher.RemoveService(context.Background(), name); err != nil {
+				log.Warn("Failed to unregister service after failed build",
+					log.Field{Key: "name", Value: name},
+					log.Field{Key: "error", Value: err})
+			}
+		}
+		for i := len(a.buildInits) - 1; i >= 0; i-- {
+			if err := a.launcher.RemoveInitializer(a.buildInits[i]); err != nil {
+				log.Warn("Failed to unregister initializer after failed build",
+					log.Field{Key: "name", Value: a.buildInits[i]},
+					log.Field{Key: "error", Value: err})
+			}
+		}
+
+		a.buildInits = nil
+		a.httpServer = nil
+		a.grpcServer = nil
+		a.healthService = nil
+		a.metricsService = nil
+		a.configWatchers = nil
+	}
+}
+
+// Run starts all services and blocks until ctx is canceled, a termination
+// signal arrives, Shutdown is called or a service fails.
+//
+// Parameters:
+//   - ctx: Context whose cancellation triggers graceful shutdown
+//
+// Returns:
+//   - error: Returns error if the App is not built, was already run, or a
+//     service fails
+func (a *App) Run(ctx context.Context) error {
+	a.mu.Lock()
+	switch a.state {
+	case appCreated:
+		a.mu.Unlock()
+		return fmt.Errorf("application not built: call Build before Run")
+	case appBuilding:
+		a.mu.Unlock()
+		return fmt.Errorf("application build in progress: call Run after Build returns")
+	case appRunning:
+		a.mu.Unlock()
+		return fmt.Errorf("application already running")
+	case appStopped:
+		a.mu.Unlock()
+		return fmt.Errorf("application already stopped")
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	a.state = appRunning
+	a.cancelRun = cancel
+	a.done = done
+	a.mu.Unlock()
+
+	defer func() {
+		cancel()
+		a.stopWatching()
+
+		a.mu.Lock()
+		a.state = appStopped
+		a.mu.Unlock()
+		close(done)
+	}()
+
+	log.Info("Launching services",
+		log.Field{Key: "service_count", Value: len(a.Services())})
+
+	if err := a.launcher.Run(runCtx); err != nil {
+		return fmt.Errorf("service launcher failed: %w", err)
+	}
+
+	log.Info("Service shutdown completed")
+	return nil
+}
+
+// Shutdown stops a running App and waits for Run to return. Calling it on
+// an App that is not running releases the resources held since Build and
+// prevents a later Run.
+//
+// Parameters:
+//   - ctx: Context bounding how long to wait for Run to return
+//
+// Returns:
+//   - error: Returns error if ctx expires before Run returns
+func (a *App) Shutdown(ctx context.Context) error {
+	a.mu.Lock()
+	state, cancel, done := a.state, a.cancelRun, a.done
+	if state != appRunning {
+		if state == appBuilt {
+			a.stopWatching()
+		}
+		a.state = appStopped
+		a.mu.Unlock()
+		return nil
+	}
+	a.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown did not complete: %w", ctx.Err())
+	}
+}
+
+// Config returns the service configuration the application was built from.
+func (a *App) Config() *config.Config {
+	return a.cfg
+}
+
+// Services returns every registered service in registration order,
+// including the built-in servers, monitoring services and config watchers.
+//
+// Returns:
+//   - []service.Service: Registered services
+func (a *App) Services() []service.Service {
+	return a.launcher.Services()
+}
+
+// Ports returns the listening ports of the enabled servers. A server that
+// is listening reports the port it actually bound, read from its listener;
+// before that, and after Build, the configured port is reported. Wait for
+// the server's Ready channel when the bound port matters.
+//
+// Returns:
+//   - Ports: Resolved ports, 0 for disabled servers
+//
+// Example:
+//
+//	<-app.HTTPServer().Ready()
+//	url := fmt.Sprintf("http://127.0.0.1:%d/hello", app.Ports().BusinessHTTP)
+func (a *App) Ports() Ports {
+	var ports Ports
+	if a.cfg.EnableBusinessHTTP {
+		ports.BusinessHTTP = a.cfg.BusinessHTTPPort
+		if a.httpServer != nil {
+			ports.BusinessHTTP = boundPort(a.httpServer.Addr(), ports.BusinessHTTP)
+		}
+	}
+	if a.cfg.EnableBusinessGRPC {
+		ports.BusinessGRPC = a.cfg.BusinessGRPCPort
+		if a.grpcServer != nil {
+			ports.BusinessGRPC = boundPort(a.grpcServer.Addr(), ports.BusinessGRPC)
+		}
+	}
+	if a.cfg.EnableHealthCheck {
+		ports.HealthCheck = a.cfg.HealthCheckPort
+		if a.healthService != nil {
+			ports.HealthCheck = boundPort(a.healthService.Addr(), ports.HealthCheck)
+		}
+	}
+	if a.cfg.EnableMetrics {
+		ports.Metrics = a.cfg.MetricsPort
+		if a.metricsService != nil {
+			ports.Metrics = boundPort(a.metricsService.Addr(), ports.Metrics)
+		}
+	}
+	return ports
+}
+
+// boundPort returns the TCP port of addr, or configured when the server
+// is not listening yet.
+func boundPort(addr net.Addr, configured int) int {
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		return tcp.Port
+	}
+	return configured
+}
+
+// Features returns which optional components are enabled.
+//
+// Returns:
+//   - Features: Enabled components
+func (a *App) Features() Features {
+	return Features{
+		BusinessHTTP:   a.cfg.EnableBusinessHTTP,
+		BusinessGRPC:   a.cfg.EnableBusinessGRPC,
+		HealthCheck:    a.cfg.EnableHealthCheck,
+		Metrics:        a.cfg.EnableMetrics,
+		Configz:        a.cfg.EnableConfigz,
+		K8sConfigWatch: a.cfg.EnableK8sConfigWatch,
+		Database:       a.cfg.DatabaseDSN != "",
+	}
+}
+
+// HTTPServer returns the business HTTP server.
+//
+// Returns:
+//   - *server.HTTPServer: The server, or nil if ENABLE_BUSINESS_HTTP is false
+//     or the App is not built
+func (a *App) HTTPServer() *server.HTTPServer {
+	return a.httpServer
+}
+
+// GRPCServer returns the business gRPC server. Register gRPC services on
+// GRPCServer().GetServer().
+//
+// Returns:
+//   - *server.GRPCServer: The server, or nil if ENABLE_BUSINESS_GRPC is false
+//     or the App is not built
+func (a *App) GRPCServer() *server.GRPCServer {
+	return a.grpcServer
+}
+
+// HealthService returns the health check service.
+//
+// Returns:
+//   - *monitoring.HealthService: The service, or nil if ENABLE_HEALTH_CHECK is
+//     false or the App is not built
+func (a *App) HealthService() *monitoring.HealthService {
+	return a.healthService
+}
+
+// MetricsService returns the metrics service.
+//
+// Returns:
+//   - *monitoring.MetricsService: The service, or nil if ENABLE_METRICS is
+//     false or the App is not built
+func (a *App) MetricsService() *monitoring.MetricsService {
+	return a.metricsService
+}
+
+// ShutdownReport returns how each service was stopped when Run last shut
+// down, including services abandoned because they exceeded their stop
+// budget.
+//
+// Returns:
+//   - *service.ShutdownReport: The report, or nil if the App has not shut down
+func (a *App) ShutdownReport() *service.ShutdownReport {
+	return a.launcher.ShutdownReport()
+}
+
+// Reload re-reads the configuration from its live sources and runs the
+// WithReload functions. The Kubernetes config watchers fetch their
+// resources from the API server and apply them through config.UpdateFrom,
+// so subscribers see the changes as usual. Run calls Reload on SIGHUP.
+//
+// Parameters:
+//   - ctx: Context for the reload
+//
+// Returns:
+//   - error: Every error from the watchers and reload functions, joined
+func (a *App) Reload(ctx context.Context) error {
+	var errs []error
+	for _, watcher := range a.configWatchers {
+		if err := watcher.Reload(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, reload := range a.opts.reloads {
+		if err := reload(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AddService registers additional services with the application.
+// Services added before Run are started with the built-in ones; services
+// added while running are started at once.
+//
+// Parameters:
+//   - svcs: Services to run
+func (a *App) AddService(svcs ...service.Service) {
+	a.launcher.AddService(svcs...)
+}
+
+// AddNamedService registers a service under a unique name with options such
+// as service.DependsOn. The built-in services are named "business-http",
+// "business-grpc", "health-check" and "metrics". Services added while the
+// App is running are started at once; use RemoveService to stop them.
+//
+// Parameters:
+//   - name: Unique service name
+//   - svc: Service to run
+//   - opts: Service options
+//
+// Returns:
+//   - error: Returns error if the name is taken, dependencies form a cycle
+//     or the App is shutting down
+//
+// Example:
+//
+//	app.AddNamedService("cache-warmer", warmer)
+//	app.AddNamedService("consumer", consumer, service.DependsOn("cache-warmer", "business-grpc"))
+func (a *App) AddNamedService(name string, svc service.Service, opts ...service.ServiceOption) error {
+	return a.launcher.AddNamedService(name, svc, opts...)
+}
+
+// RemoveService stops and unregisters a service added with AddService or
+// AddNamedService. See service.Launcher.RemoveService.
+//
+// Parameters:
+//   - ctx: Context bounding how long to wait for the service to stop
+//   - name: Name of the service to remove
+//
+// Returns:
+//   - error: Returns error if the service is unknown, required by another
+//     service, or failed to stop
+func (a *App) RemoveService(ctx context.Context, name string) error {
+	return a.launcher.RemoveService(ctx, name)
+}
+
+// Status returns a snapshot of every service: its state, start time,
+// restart count and last error. The same report is served at /statusz on
+// the health check port.
+//
+// Returns:
+//   - []service.ServiceStatus: Status of each service, in registration order
+func (a *App) Status() []service.ServiceStatus {
+	return a.launcher.Status()
+}
+
+// AddNamedInitializer registers an initializer to run before services start,
+// with options such as service.InitAfter and service.InitTimeout.
+// Initializers without dependencies on each other run concurrently. The
+// built-in database initializer is named "database".
+//
+// Parameters:
+//   - name: Unique initializer name
+//   - init: Initializer to run
+//   - opts: Initializer options
+//
+// Returns:
+//   - error: Returns error if the name is taken or dependencies form a cycle
+//
+// Example:
+//
+//	app.AddNamedInitializer("cache", cacheInit, service.InitTimeout(5*time.Second))
+//	app.AddNamedInitializer("schema", migrator, service.InitAfter("database"))
+func (a *App) AddNamedInitializer(name string, init service.Initializer, opts ...service.InitializerOption) error {
+	if err := a.launcher.AddNamedInitializer(name, init, opts...); err != nil {
+		return err
+	}
+	if a.building {
+		a.buildInits = append(a.buildInits, name)
+	}
+	return nil
+}
+
+// AddHealthChecker adds a checker to the readiness and health endpoints.
+// If the health check service is disabled the checker is ignored and a
+// warning is logged.
+//
+// Parameters:
+//   - checker: Health checker to add
+func (a *App) AddHealthChecker(checker monitoring.HealthChecker) {
+	if a.healthService == nil {
+		log.Warn("Health check service disabled, ignoring health checker",
+			log.Field{Key: "checker", Value: checker.Name()})
+		return
+	}
+	a.healthService.AddHealthChecker(checker)
+}
+
+// RegisterCollector registers a Prometheus collector with the metrics service.
+//
+// Parameters:
+//   - collector: Prometheus collector to register
+//
+// Returns:
+//   - error: Returns error if the metrics service is disabled or registration fails
+func (a *App) RegisterCollector(collector prometheus.Collector) error {
+	if a.metricsService == nil {
+		return fmt.Errorf("cannot register collector: metrics service is disabled")
+	}
+	return a.metricsService.RegisterCollector(collector)
+}
@@ -0,0 +1,343 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/config"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
+)
+
+// newAppTestConfig returns a valid configuration with every component enabled.
+func newAppTestConfig() *config.Config {
+	return &config.Config{
+		ServiceName:        "test-service",
+		Environment:        "testing",
+		BusinessHTTPPort:   18180,
+		BusinessGRPCPort:   19190,
+		HealthCheckPort:    18181,
+		MetricsPort:        19191,
+		LogLevel:           "info",
+		LogFormat:          "json",
+		EnableBusinessHTTP: true,
+		EnableBusinessGRPC: true,
+		EnableHealthCheck:  true,
+		EnableMetrics:      true,
+	}
+}
+
+// stubChecker is a health checker that always reports healthy.
+type stubChecker struct{}
+
+func (stubChecker) Name() string                    { return "stub" }
+func (stubChecker) Check(ctx context.Context) error { return nil }
+
+// mockInitializer is an initializer that always succeeds.
+type mockInitializer struct{}
+
+func (mockInitializer) Init(ctx context.Context) error { return nil }
+
+// TestBootstrapWithOptions_SetupReceivesServers tests the setup hook.
+// This verifies setup functions see the created servers and that a setup error aborts startup.
+func TestBootstrapWithOptions_SetupReceivesServers(t *testing.T) {
+	svc := &mockService{}
+	var calls []string
+
+	err := BootstrapWithOptions(context.Background(), newAppTestConfig(),
+		WithServices(svc),
+		WithSetup(func(app *App) error {
+			calls = append(calls, "first")
+			require.NotNil(t, app.HTTPServer())
+			require.NotNil(t, app.GRPCServer())
+			require.NotNil(t, app.HealthService())
+			require.NotNil(t, app.MetricsService())
+			assert.Equal(t, "test-service", app.Config().ServiceName)
+
+			// The launcher readiness check is registered by default
+			assert.Equal(t, 1, app.HealthService().GetCheckerCount())
+			app.AddHealthChecker(stubChecker{})
+			assert.Equal(t, 2, app.HealthService().GetCheckerCount())
+			return app.RegisterCollector(prometheus.NewCounter(prometheus.CounterOpts{
+				Name: "setup_test_total",
+				Help: "Counter registered from a setup function",
+			}))
+		}),
+		WithSetup(func(app *App) error {
+			calls = append(calls, "second")
+			return errors.New("setup boom")
+		}),
+	)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "application setup failed: setup boom")
+	assert.Equal(t, []string{"first", "second"}, calls)
+	assert.False(t, svc.started, "Services must not start when setup fails")
+}
+
+// TestBootstrapWithOptions_ServesRegisteredRoutes tests routes added during setup.
+// This verifies handlers registered through the App are served by the business HTTP server with request IDs.
+func TestBootstrapWithOptions_ServesRegisteredRoutes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- BootstrapWithOptions(ctx, newAppTestConfig(),
+			WithSetup(func(app *App) error {
+				app.HTTPServer().HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte("hello"))
+				})
+				return nil
+			}),
+		)
+	}()
+
+	var body []byte
+	var requestID string
+	require.Eventually(t, func() bool {
+		req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1:18180/hello", nil)
+		req.Header.Set("X-Request-ID", "client-1")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		body, _ = io.ReadAll(resp.Body)
+		requestID = resp.Header.Get("X-Request-ID")
+		return resp.StatusCode == http.StatusOK
+	}, 3*time.Second, 50*time.Millisecond)
+	assert.Equal(t, "hello", string(body))
+	assert.Equal(t, "client-1", requestID, "Business HTTP requests carry the caller's request ID")
+
+	cancel()
+	assert.NoError(t, <-errCh)
+}
+
+// TestApp_DisabledServices tests the App accessors with monitoring disabled.
+// This verifies disabled components are reported as nil and handled gracefully.
+func TestApp_DisabledServices(t *testing.T) {
+	log.Init("info", "json")
+	app := newApp(&config.Config{}, nil)
+
+	assert.Nil(t, app.HTTPServer())
+	assert.Nil(t, app.GRPCServer())
+	assert.Nil(t, app.HealthService())
+	assert.Nil(t, app.MetricsService())
+
+	assert.NotPanics(t, func() { app.AddHealthChecker(stubChecker{}) })
+
+	err := app.RegisterCollector(prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "disabled_test_total",
+		Help: "Counter registered without a metrics service",
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "metrics service is disabled")
+}
+
+// TestApp_Lifecycle tests driving the App phase by phase.
+// This verifies the App can be inspected after Build and stopped with Shutdown.
+func TestApp_Lifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test")
+	}
+
+	cfg := newAppTestConfig()
+	cfg.EnableBusinessGRPC = false
+	svc := &mockService{}
+	app := NewApp(cfg, WithServices(svc))
+
+	assert.Empty(t, app.Services())
+	require.Error(t, app.Run(context.Background()), "Run before Build must fail")
+
+	require.NoError(t, app.Build(context.Background()))
+	assert.Error(t, app.Build(context.Background()), "Build must not run twice")
+
+	// HTTP server, health, metrics and the business service
+	assert.Len(t, app.Services(), 4)
+	assert.Contains(t, app.Services(), service.Service(svc))
+	assert.Equal(t, Ports{BusinessHTTP: 18180, HealthCheck: 18181, Metrics: 19191}, app.Ports())
+	assert.Equal(t, Features{BusinessHTTP: true, HealthCheck: true, Metrics: true}, app.Features())
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- app.Run(context.Background())
+	}()
+
+	// /readyz turns ready once every service, including the business ones, is ready
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://127.0.0.1:18181/readyz")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 3*time.Second, 50*time.Millisecond)
+
+	// Once listening, Ports reports the bound ports
+	bound, ok := app.HTTPServer().Addr().(*net.TCPAddr)
+	require.True(t, ok)
+	assert.Equal(t, bound.Port, app.Ports().BusinessHTTP)
+
+	// /statusz reports the state of every service
+	resp, err := http.Get("http://127.0.0.1:18181/statusz")
+	require.NoError(t, err)
+	var status struct {
+		Services []service.ServiceStatus `json:"services"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	resp.Body.Close()
+	require.Len(t, status.Services, 4)
+	for i, s := range app.Status() {
+		assert.Equal(t, s.Name, status.Services[i].Name)
+		assert.Equal(t, service.StateRunning, status.Services[i].State, s.Name)
+		assert.False(t, status.Services[i].StartedAt.IsZero())
+	}
+	http.DefaultClient.CloseIdleConnections()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Nil(t, app.ShutdownReport())
+	require.NoError(t, app.Shutdown(shutdownCtx))
+	assert.NoError(t, <-errCh)
+
+	report := app.ShutdownReport()
+	require.NotNil(t, report)
+	assert.Len(t, report.Services, 4)
+	assert.Empty(t, report.Abandoned())
+
+	assert.Error(t, app.Run(context.Background()), "A stopped App cannot be run again")
+}
+
+// TestApp_BuildRetryAfterFailure tests building again after a failed Build.
+// This verifies a failed Build undoes its registrations and global config so a retry succeeds.
+func TestApp_BuildRetryAfterFailure(t *testing.T) {
+	previous := &config.Config{ServiceName: "previous-service"}
+	config.Set(previous)
+	defer config.Set(nil)
+
+	svc := &mockService{}
+	attempts := 0
+	app := NewApp(newAppTestConfig(),
+		WithServices(svc),
+		WithSetup(func(app *App) error {
+			attempts++
+			// Re-registering on retry proves the failed Build removed them
+			if err := app.AddNamedService("worker", &mockService{}); err != nil {
+				return err
+			}
+			if err := app.AddNamedInitializer("cache", mockInitializer{}); err != nil {
+				return err
+			}
+			if attempts == 1 {
+				return errors.New("setup boom")
+			}
+			return nil
+		}),
+	)
+
+	err := app.Build(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "application setup failed: setup boom")
+	assert.Empty(t, app.Services(), "A failed Build unregisters its services")
+	assert.Nil(t, app.HTTPServer())
+	assert.Same(t, previous, config.Get(), "A failed Build restores the global config")
+
+	require.NoError(t, app.Build(context.Background()), "Build can be retried after a failure")
+	assert.Equal(t, 2, attempts)
+	// HTTP server, gRPC server, health, metrics, the business service and worker
+	assert.Len(t, app.Services(), 6)
+	assert.Same(t, app.Config(), config.Get())
+
+	err = app.Build(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already built")
+	assert.Equal(t, 2, attempts, "A rejected Build runs no setup")
+	require.NoError(t, app.Shutdown(context.Background()))
+}
+
+// TestApp_LifecycleCallsFromSetup tests calling Run and Shutdown from setup functions.
+// This verifies neither deadlocks: Run is rejected and Shutdown aborts the build.
+func TestApp_LifecycleCallsFromSetup(t *testing.T) {
+	var runErr error
+	app := NewApp(newAppTestConfig(), WithSetup(func(app *App) error {
+		runErr = app.Run(context.Background())
+		return app.Shutdown(context.Background())
+	}))
+
+	err := app.Build(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "shut down during build")
+	require.Error(t, runErr)
+	assert.Contains(t, runErr.Error(), "build in progress")
+	assert.Empty(t, app.Services(), "An aborted build is rolled back")
+	assert.Error(t, app.Build(context.Background()), "A shut down App cannot be built again")
+}
+
+// TestApp_ShutdownBeforeRun tests Shutdown on an App that never ran.
+// This verifies Shutdown succeeds and prevents a later Run.
+func TestApp_ShutdownBeforeRun(t *testing.T) {
+	app := NewApp(newAppTestConfig())
+	require.NoError(t, app.Build(context.Background()))
+
+	require.NoError(t, app.Shutdown(context.Background()))
+
+	err := app.Run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already stopped")
+}
+
+// TestApp_RunReportsServiceFailure tests error propagation from failed services.
+// This verifies Run returns a service.ServiceError naming the failed service.
+func TestApp_RunReportsServiceFailure(t *testing.T) {
+	cfg := &config.Config{ServiceName: "test-service", LogLevel: "info", LogFormat: "json"}
+	app := NewApp(cfg, WithSetup(func(app *App) error {
+		return app.AddNamedService("worker", &mockService{startError: errors.New("queue unreachable")})
+	}))
+	require.NoError(t, app.Build(context.Background()))
+
+	err := app.Run(context.Background())
+
+	var svcErr *service.ServiceError
+	require.True(t, errors.As(err, &svcErr))
+	assert.Equal(t, "worker", svcErr.Name)
+	assert.Contains(t, err.Error(), "service worker failed: queue unreachable")
+}
+
+// TestApp_Reload tests reloading configuration through the App.
+// This verifies WithReload functions run in order and their errors are joined.
+func TestApp_Reload(t *testing.T) {
+	cfg := &config.Config{ServiceName: "test-service", LogLevel: "info", LogFormat: "json"}
+	var calls []string
+	app := NewApp(cfg,
+		WithReload(func(ctx context.Context) error {
+			calls = append(calls, "flags")
+			return errors.New("flag service unavailable")
+		}),
+		WithReload(func(ctx context.Context) error {
+			calls = append(calls, "limits")
+			return nil
+		}),
+	)
+	require.NoError(t, app.Build(context.Background()))
+	defer app.Shutdown(context.Background())
+
+	err := app.Reload(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "flag service unavailable")
+	assert.Equal(t, []string{"flags", "limits"}, calls)
+}
@@ -5,8 +5,12 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"syscall"
+
+	"google.golang.org/grpc"
 
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/config"
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/db"
@@ -16,7 +20,7 @@ import (
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
 )
 
-// BootstrapWithContext is the same as Bootstrap but accepts a context for cancellation.
+// Bootstrap is the single entry point for all EggyByte services.
 // It orchestrates the complete application lifecycle including:
 //   - Configuration loading and validation
 //   - Logging initialization with structured output
@@ -36,6 +40,7 @@ import (
 //   - error: Returns error if any initialization or startup step fails
 //
 // Behavior:
+//   - Validates the configuration with config.ValidateConfig, reporting all violations
 //   - Initializes logging based on config (level, format)
 //   - Creates service launcher with proper lifecycle management
 //   - Conditionally registers database initializer if DSN provided
@@ -43,6 +48,7 @@ import (
 //   - Registers health check and metrics services on separate ports
 //   - Registers any additional business services provided
 //   - Runs launcher with signal handling and graceful shutdown
+//   - Closes the database connection and flushes logs after services stop
 //
 // Environment Variables:
 //   - SERVICE_NAME: Required service identifier
@@ -54,6 +60,12 @@ import (
 //   - ENABLE_BUSINESS_GRPC: Enable gRPC server (default: true)
 //   - ENABLE_HEALTH_CHECK: Enable health check server (default: true)
 //   - ENABLE_METRICS: Enable metrics server (default: true)
+//   - ENABLE_CONFIGZ: Serve /configz on the health check port (default: false)
+//   - SHUTDOWN_DRAIN_PERIOD: Time /readyz fails before services stop on SIGTERM (default: 0s)
+//
+// Signals:
+//   - SIGINT, SIGTERM: Graceful shutdown after the drain period
+//   - SIGHUP: Reload configuration (see App.Reload)
 //
 // Example:
 //
@@ -76,174 +88,120 @@ import (
 //	if err := core.Bootstrap(cfg, customService); err != nil {
 //	    log.Fatal("Bootstrap failed", log.Field{Key: "error", Value: err})
 //	}
-//
-// BootstrapWithContext is the same as Bootstrap but accepts a context for cancellation.
-// This is useful for testing and scenarios where you need to control the lifecycle.
-func BootstrapWithContext(ctx context.Context, cfg *config.Config, businessServices ...service.Service) error {
-	// Phase 1: Initialize logging system
-	if err := initializeLogging(cfg); err != nil {
-		return fmt.Errorf("failed to initialize logging: %w", err)
-	}
-
-	log.Info("Starting service bootstrap",
-		log.Field{Key: "service", Value: cfg.ServiceName},
-		log.Field{Key: "environment", Value: cfg.Environment})
-
-	// Phase 2: Set global configuration
-	config.Set(cfg)
-
-	// Phase 3: Create service launcher
-	launcher := service.NewLauncher()
-	launcher.SetLogger(log.Default())
-
-	// Phase 4: Register infrastructure initializers
-	if err := registerInitializers(launcher, cfg); err != nil {
-		return err
-	}
-
-	// Phase 5: Create and register business servers
-	if err := registerBusinessServers(launcher, cfg); err != nil {
-		return err
-	}
-
-	// Phase 6: Register infrastructure services
-	registerInfraServices(launcher, cfg)
-
-	// Phase 7: Register additional business services
-	for _, svc := range businessServices {
-		launcher.AddService(svc)
-	}
-
-	// Phase 8: Run launcher with complete lifecycle management
-	log.Info("Launching services",
-		log.Field{Key: "service_count", Value: len(businessServices) + 3}) // +3 for business servers and monitoring
-
-	if err := launcher.Run(ctx); err != nil {
-		return fmt.Errorf("service launcher failed: %w", err)
-	}
-
-	log.Info("Service shutdown completed")
-	return nil
+func Bootstrap(cfg *config.Config, businessServices ...service.Service) error {
+	return BootstrapWithOptions(context.Background(), cfg, WithServices(businessServices...))
 }
 
-// Bootstrap is the single entry point for all EggyByte services.
-// It orchestrates the complete application lifecycle including:
-//   - Configuration loading and validation
-//   - Logging initialization with structured output
-//   - Infrastructure setup (database, cache, monitoring)
-//   - Business server creation (HTTP/gRPC based on configuration)
-//   - Health check and metrics service registration
-//   - Graceful shutdown handling with proper signal management
-//
-// This function simplifies service creation to a single call with
-// automatic handling of all boilerplate setup and teardown.
+// BootstrapWithContext is the same as Bootstrap but accepts a context for cancellation.
+// This is useful for testing and scenarios where you need to control the lifecycle.
 //
 // Parameters:
+//   - ctx: Context whose cancellation triggers graceful shutdown
 //   - cfg: Service configuration loaded from environment variables
 //   - businessServices: Application-specific services to run (optional)
 //
 // Returns:
 //   - error: Returns error if any initialization or startup step fails
+func BootstrapWithContext(ctx context.Context, cfg *config.Config, businessServices ...service.Service) error {
+	return BootstrapWithOptions(ctx, cfg, WithServices(businessServices...))
+}
+
+// BootstrapWithOptions runs the same lifecycle as Bootstrap, customized by
+// functional options. It is equivalent to NewApp followed by Build and
+// Run. WithSetup functions receive an *App handle after all servers and
+// services are registered and before any of them starts, so routes, gRPC
+// services, health checkers and metrics collectors can be added to the
+// servers Bootstrap creates.
 //
-// Behavior:
-//   - Initializes logging based on config (level, format)
-//   - Creates service launcher with proper lifecycle management
-//   - Conditionally registers database initializer if DSN provided
-//   - Creates and registers business HTTP/gRPC servers based on configuration
-//   - Registers health check and metrics services on separate ports
-//   - Registers any additional business services provided
-//   - Runs launcher with signal handling and graceful shutdown
+// Parameters:
+//   - ctx: Context whose cancellation triggers graceful shutdown
+//   - cfg: Service configuration loaded from environment variables
+//   - opts: Options such as WithServices and WithSetup
 //
-// Environment Variables:
-//   - SERVICE_NAME: Required service identifier
-//   - BUSINESS_HTTP_PORT: HTTP server port (default: 8080)
-//   - BUSINESS_GRPC_PORT: gRPC server port (default: 9090)
-//   - HEALTH_CHECK_PORT: Health check port (default: 8081)
-//   - METRICS_PORT: Metrics exposition port (default: 9091)
-//   - ENABLE_BUSINESS_HTTP: Enable HTTP server (default: true)
-//   - ENABLE_BUSINESS_GRPC: Enable gRPC server (default: true)
-//   - ENABLE_HEALTH_CHECK: Enable health check server (default: true)
-//   - ENABLE_METRICS: Enable metrics server (default: true)
+// Returns:
+//   - error: Returns error if any initialization, setup or startup step fails
 //
 // Example:
 //
-//	cfg := &config.Config{}
-//	if err := config.ReadFromEnv(cfg); err != nil {
-//	    log.Fatal("Failed to load config", log.Field{Key: "error", Value: err})
-//	}
-//
-//	// Bootstrap will automatically create HTTP/gRPC servers based on config
-//	if err := core.Bootstrap(cfg); err != nil {
-//	    log.Fatal("Bootstrap failed", log.Field{Key: "error", Value: err})
-//	}
-//
-// Example with custom business services:
-//
-//	cfg := &config.Config{}
-//	config.MustReadFromEnv(cfg)
-//
-//	customService := myapp.NewCustomService()
-//	if err := core.Bootstrap(cfg, customService); err != nil {
-//	    log.Fatal("Bootstrap failed", log.Field{Key: "error", Value: err})
-//	}
-func Bootstrap(cfg *config.Config, businessServices ...service.Service) error {
-	// Phase 1: Initialize logging system
-	if err := initializeLogging(cfg); err != nil {
-		return fmt.Errorf("failed to initialize logging: %w", err)
-	}
-
-	log.Info("Starting service bootstrap",
-		log.Field{Key: "service", Value: cfg.ServiceName},
-		log.Field{Key: "environment", Value: cfg.Environment})
-
-	// Phase 2: Set global configuration
-	config.Set(cfg)
-
-	// Phase 3: Create service launcher
-	launcher := service.NewLauncher()
-	launcher.SetLogger(log.Default())
-
-	// Phase 4: Register infrastructure initializers
-	if err := registerInitializers(launcher, cfg); err != nil {
+//	err := core.BootstrapWithOptions(ctx, cfg,
+//	    core.WithServices(worker),
+//	    core.WithSetup(func(app *core.App) error {
+//	        app.HTTPServer().HandleFunc("/api/v1/users", userHandler)
+//	        pb.RegisterUserServiceServer(app.GRPCServer().GetServer(), userService)
+//	        return nil
+//	    }),
+//	)
+func BootstrapWithOptions(ctx context.Context, cfg *config.Config, opts ...Option) error {
+	app := NewApp(cfg, opts...)
+	if err := app.Build(ctx); err != nil {
 		return err
 	}
+	return app.Run(ctx)
+}
 
-	// Phase 5: Create and register business servers
-	if err := registerBusinessServers(launcher, cfg); err != nil {
-		return err
+// initializeLogging configures the global logger based on configuration.
+// Returns error if log level or format is invalid.
+func initializeLogging(cfg *config.Config) error {
+	if err := log.Init(cfg.LogLevel, cfg.LogFormat); err != nil {
+		return fmt.Errorf("invalid log configuration: %w", err)
 	}
+	return nil
+}
 
-	// Phase 6: Register infrastructure services
-	registerInfraServices(launcher, cfg)
-
-	// Phase 7: Register additional business services
-	for _, svc := range businessServices {
-		launcher.AddService(svc)
+// watchConfigChanges subscribes infrastructure components to configuration
+// changes so they reconfigure themselves without a restart.
+// Subscriptions are removed when ctx is canceled.
+//
+// Behavior:
+//   - Re-initializes logging when LogLevel or LogFormat changes
+//   - Resizes the database connection pool when pool sizes change
+func watchConfigChanges(ctx context.Context) error {
+	reconfigureLogging := func(old, new *config.Config) {
+		if new == nil {
+			return
+		}
+		if err := initializeLogging(new); err != nil {
+			log.Error("Failed to apply logging configuration change",
+				log.Field{Key: "error", Value: err})
+			return
+		}
+		log.Info("Logging reconfigured",
+			log.Field{Key: "level", Value: new.LogLevel},
+			log.Field{Key: "format", Value: new.LogFormat})
 	}
 
-	// Phase 8: Run launcher with complete lifecycle management
-	log.Info("Launching services",
-		log.Field{Key: "service_count", Value: len(businessServices) + 3}) // +3 for business servers and monitoring
-
-	if err := launcher.Run(context.Background()); err != nil {
-		return fmt.Errorf("service launcher failed: %w", err)
+	resizeDatabasePool := func(old, new *config.Config) {
+		if new == nil {
+			return
+		}
+		if err := db.SetPoolSize(new.DatabaseMaxOpenConns, new.DatabaseMaxIdleConns); err != nil {
+			log.Error("Failed to apply database pool configuration change",
+				log.Field{Key: "error", Value: err})
+		}
 	}
 
-	log.Info("Service shutdown completed")
-	return nil
-}
+	subscriptions := []struct {
+		field string
+		fn    config.ChangeFunc
+	}{
+		{"LogLevel", reconfigureLogging},
+		{"LogFormat", reconfigureLogging},
+		{"DatabaseMaxOpenConns", resizeDatabasePool},
+		{"DatabaseMaxIdleConns", resizeDatabasePool},
+	}
 
-// initializeLogging configures the global logger based on configuration.
-// Returns error if log level or format is invalid.
-func initializeLogging(cfg *config.Config) error {
-	if err := log.Init(cfg.LogLevel, cfg.LogFormat); err != nil {
-		return fmt.Errorf("invalid log configuration: %w", err)
+	for _, sub := range subscriptions {
+		if err := config.SubscribeField(ctx, sub.field, sub.fn); err != nil {
+			return fmt.Errorf("failed to watch config field %s: %w", sub.field, err)
+		}
 	}
+
 	return nil
 }
 
 // registerInitializers registers infrastructure initializers with the launcher.
-// Registers database initializer if configuration is provided.
+// Registers database initializer if configuration is provided, along with
+// a hook closing the connection once all services have stopped.
 func registerInitializers(launcher *service.Launcher, cfg *config.Config) error {
 	// Database initializer (conditional)
 	if cfg.DatabaseDSN != "" {
@@ -257,7 +215,12 @@ func registerInitializers(launcher *service.Launcher, cfg *config.Config) error
 		}
 
 		dbInit := db.NewTiDBInitializer(dbConfig)
-		launcher.AddInitializer(dbInit)
+		if err := launcher.AddNamedInitializer("database", dbInit); err != nil {
+			return fmt.Errorf("failed to register database initializer: %w", err)
+		}
+		launcher.OnStopped(func(ctx context.Context) error {
+			return db.Close()
+		})
 
 		log.Info("Database initializer registered")
 	} else {
@@ -267,12 +230,23 @@ func registerInitializers(launcher *service.Launcher, cfg *config.Config) error
 	return nil
 }
 
+// syncLogger flushes buffered log entries of the default logger.
+// It runs as the last OnStopped hook so shutdown logs are written.
+// Errors from syncing a terminal or pipe, which cannot be synced, are ignored.
+func syncLogger(ctx context.Context) error {
+	err := log.Default().Sync()
+	if errors.Is(err, syscall.EINVAL) || errors.Is(err, syscall.ENOTTY) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to sync logger: %w", err)
+	}
+	return nil
+}
+
 // registerBusinessServers creates and registers business HTTP/gRPC servers based on configuration.
-// This function creates servers only if they are enabled in the configuration.
-//
-// Parameters:
-//   - launcher: The service launcher to register servers with
-//   - cfg: Service configuration containing server settings
+// This function creates servers only if they are enabled in the configuration
+// and records them on the App.
 //
 // Returns:
 //   - error: Returns error if server creation fails
@@ -280,9 +254,12 @@ func registerInitializers(launcher *service.Launcher, cfg *config.Config) error
 // Behavior:
 //   - Creates HTTP server if ENABLE_BUSINESS_HTTP is true
 //   - Creates gRPC server if ENABLE_BUSINESS_GRPC is true
+//   - Gives every business request an X-Request-ID / x-request-id and a
+//     request-scoped logger, reusing the caller's ID when present
 //   - Registers servers with the launcher for lifecycle management
 //   - Logs server creation and configuration details
-func registerBusinessServers(launcher *service.Launcher, cfg *config.Config) error {
+func (a *App) registerBusinessServers() error {
+	cfg := a.cfg
 	var serverCount int
 
 	// Create HTTP server if enabled
@@ -290,9 +267,13 @@ func registerBusinessServers(launcher *service.Launcher, cfg *config.Config) err
 		httpPort := ":" + strconv.Itoa(cfg.BusinessHTTPPort)
 		httpServer := server.NewHTTPServer(httpPort)
 		httpServer.SetLogger(log.Default())
-		if launcher != nil {
-			launcher.AddService(httpServer)
+		httpServer.Use(server.RequestID())
+		if a.launcher != nil {
+			if err := a.launcher.AddNamedService("business-http", httpServer); err != nil {
+				return fmt.Errorf("failed to register business HTTP server: %w", err)
+			}
 		}
+		a.httpServer = httpServer
 		serverCount++
 
 		log.Info("Business HTTP server registered",
@@ -303,11 +284,16 @@ func registerBusinessServers(launcher *service.Launcher, cfg *config.Config) err
 	// Create gRPC server if enabled
 	if cfg.EnableBusinessGRPC {
 		grpcPort := ":" + strconv.Itoa(cfg.BusinessGRPCPort)
-		grpcServer := server.NewGRPCServer(grpcPort)
+		grpcServer := server.NewGRPCServerWithOptions(grpcPort,
+			grpc.ChainUnaryInterceptor(server.RequestIDUnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(server.RequestIDStreamServerInterceptor()))
 		grpcServer.SetLogger(log.Default())
-		if launcher != nil {
-			launcher.AddService(grpcServer)
+		if a.launcher != nil {
+			if err := a.launcher.AddNamedService("business-grpc", grpcServer); err != nil {
+				return fmt.Errorf("failed to register business gRPC server: %w", err)
+			}
 		}
+		a.grpcServer = grpcServer
 		serverCount++
 
 		log.Info("Business gRPC server registered",
@@ -328,48 +314,213 @@ func registerBusinessServers(launcher *service.Launcher, cfg *config.Config) err
 }
 
 // registerInfraServices registers core infrastructure services
-// (health check and metrics services) with the launcher.
+// (health check, metrics and config watcher services) with the launcher.
 // These services run on separate ports for security and monitoring isolation.
+// The health check and metrics services are recorded on the App.
 //
-// Parameters:
-//   - launcher: The service launcher to register services with
-//   - cfg: Service configuration containing service settings
+// Returns:
+//   - error: Returns error if the Kubernetes config watcher cannot be created
 //
 // Behavior:
-//   - Registers health check service if ENABLE_HEALTH_CHECK is true
+//   - Registers health check service if ENABLE_HEALTH_CHECK is true, with
+//     /readyz failing until every launcher service reports ready and
+//     /statusz reporting the state of each launcher service
 //   - Registers metrics service if ENABLE_METRICS is true
+//   - Serves /configz on the health check (or metrics) port if ENABLE_CONFIGZ is true
+//   - Registers Kubernetes ConfigMap (and optional Secret) watchers if
+//     ENABLE_K8S_CONFIG_WATCH is true, reporting their sync status through
+//     the health check service
 //   - Logs service registration and endpoint information
-func registerInfraServices(launcher *service.Launcher, cfg *config.Config) {
+func (a *App) registerInfraServices() error {
+	cfg, launcher := a.cfg, a.launcher
 	var serviceCount int
+	var healthService *monitoring.HealthService
+	var metricsService *monitoring.MetricsService
 
 	// Register health check service if enabled
 	if cfg.EnableHealthCheck {
-		healthService := monitoring.NewHealthService(cfg.HealthCheckPort)
-		launcher.AddService(healthService)
+		healthService = monitoring.NewHealthService(cfg.HealthCheckPort)
+		if err := launcher.AddNamedService("health-check", healthService); err != nil {
+			return fmt.Errorf("failed to register health check service: %w", err)
+		}
+		a.healthService = healthService
+
+		// Report ready only once every registered service is ready
+		healthService.AddHealthChecker(launcher.ReadinessCheck())
+		if err := healthService.Handle("/statusz", launcher.StatusHandler()); err != nil {
+			return err
+		}
 		serviceCount++
 
 		log.Info("Health check service registered",
 			log.Field{Key: "port", Value: cfg.HealthCheckPort},
-			log.Field{Key: "endpoints", Value: "/healthz, /livez, /readyz"})
+			log.Field{Key: "endpoints", Value: "/healthz, /livez, /readyz, /statusz"})
 	}
 
 	// Register metrics service if enabled
 	if cfg.EnableMetrics {
-		metricsService := monitoring.NewMetricsService(cfg.MetricsPort)
-		launcher.AddService(metricsService)
+		metricsService = monitoring.NewMetricsService(cfg.MetricsPort)
+		if err := launcher.AddNamedService("metrics", metricsService); err != nil {
+			return fmt.Errorf("failed to register metrics service: %w", err)
+		}
+		a.metricsService = metricsService
 		serviceCount++
 
+		// Export service restart and failure metrics
+		for _, collector := range launcher.Collectors() {
+			if err := metricsService.RegisterCollector(collector); err != nil {
+				return fmt.Errorf("failed to register launcher metrics: %w", err)
+			}
+		}
+
 		log.Info("Metrics service registered",
 			log.Field{Key: "port", Value: cfg.MetricsPort},
 			log.Field{Key: "endpoints", Value: "/metrics"})
 	}
 
+	// Expose the effective configuration if enabled, preferring the health check port
+	if cfg.EnableConfigz {
+		switch {
+		case healthService != nil:
+			if err := healthService.Handle("/configz", config.Handler()); err != nil {
+				return err
+			}
+			log.Info("Config introspection endpoint registered",
+				log.Field{Key: "port", Value: cfg.HealthCheckPort},
+				log.Field{Key: "endpoint", Value: "/configz"})
+		case metricsService != nil:
+			if err := metricsService.Handle("/configz", config.Handler()); err != nil {
+				return err
+			}
+			log.Info("Config introspection endpoint registered",
+				log.Field{Key: "port", Value: cfg.MetricsPort},
+				log.Field{Key: "endpoint", Value: "/configz"})
+		default:
+			log.Warn("Config introspection enabled but no monitoring port is available")
+		}
+	}
+
+	// Register Kubernetes ConfigMap and Secret watchers if enabled
+	if cfg.EnableK8sConfigWatch {
+		watchers, err := newConfigWatchers(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to create kubernetes config watcher: %w", err)
+		}
+
+		for _, watcher := range watchers {
+			if err := launcher.AddNamedService(watcher.Name(), watcher); err != nil {
+				return fmt.Errorf("failed to register kubernetes config watcher: %w", err)
+			}
+			a.configWatchers = append(a.configWatchers, watcher)
+			serviceCount++
+
+			if healthService != nil {
+				healthService.AddHealthChecker(watcher)
+			}
+
+			log.Info("Kubernetes config watcher registered",
+				log.Field{Key: "resource", Value: watcher.Name()})
+		}
+	}
+
 	if serviceCount == 0 {
 		log.Info("No infrastructure services enabled")
 	} else {
 		log.Info("Infrastructure services registered",
 			log.Field{Key: "count", Value: serviceCount},
 			log.Field{Key: "health_enabled", Value: cfg.EnableHealthCheck},
-			log.Field{Key: "metrics_enabled", Value: cfg.EnableMetrics})
+			log.Field{Key: "metrics_enabled", Value: cfg.EnableMetrics},
+			log.Field{Key: "config_watch_enabled", Value: cfg.EnableK8sConfigWatch})
+	}
+
+	return nil
+}
+
+// registerScheduler registers the WithScheduler scheduler, if any, as the
+// "scheduler" service and exports its metrics through the metrics service.
+//
+// Returns:
+//   - error: Returns error if the scheduler or its metrics cannot be registered
+func (a *App) registerScheduler() error {
+	sched := a.opts.scheduler
+	if sched == nil {
+		return nil
+	}
+
+	sched.SetLogger(log.Default())
+	if err := a.launcher.AddNamedService("scheduler", sched); err != nil {
+		return fmt.Errorf("failed to register scheduler: %w", err)
+	}
+
+	if a.metricsService != nil {
+		for _, collector := range sched.Collectors() {
+			if err := a.metricsService.RegisterCollector(collector); err != nil {
+				return fmt.Errorf("failed to register scheduler metrics: %w", err)
+			}
+		}
+	}
+
+	log.Info("Scheduler registered")
+	return nil
+}
+
+// newConfigWatchers creates the ConfigMap watcher and, if K8S_SECRET_NAME is
+// set, a Secret watcher for the configured cluster access mode: a kubeconfig
+// file when K8S_KUBECONFIG is set, otherwise the in-cluster service account.
+// Resync period and cache-sync timeout are taken from the configuration.
+// Both watchers feed the same validated config.Update path.
+func newConfigWatchers(cfg *config.Config) ([]*config.K8sConfigWatcher, error) {
+	var watchers []*config.K8sConfigWatcher
+
+	applyConfigMapUpdate := configUpdater(config.ValueSource{Source: config.SourceConfigMap, Origin: cfg.K8sConfigMapName})
+	applySecretUpdate := configUpdater(config.ValueSource{Source: config.SourceSecret, Origin: cfg.K8sSecretName})
+
+	var (
+		watcher *config.K8sConfigWatcher
+		err     error
+	)
+	if cfg.K8sKubeconfig != "" {
+		watcher, err = config.NewK8sConfigWatcherFromKubeconfig(
+			cfg.K8sKubeconfig, cfg.K8sNamespace, cfg.K8sConfigMapName, applyConfigMapUpdate)
+	} else {
+		watcher, err = config.NewK8sConfigWatcher(cfg.K8sNamespace, cfg.K8sConfigMapName, applyConfigMapUpdate)
+	}
+	if err != nil {
+		return nil, err
+	}
+	watchers = append(watchers, watcher)
+
+	if cfg.K8sSecretName != "" {
+		if cfg.K8sKubeconfig != "" {
+			watcher, err = config.NewK8sSecretWatcherFromKubeconfig(
+				cfg.K8sKubeconfig, cfg.K8sNamespace, cfg.K8sSecretName, applySecretUpdate)
+		} else {
+			watcher, err = config.NewK8sSecretWatcher(cfg.K8sNamespace, cfg.K8sSecretName, applySecretUpdate)
+		}
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, watcher)
+	}
+
+	for _, w := range watchers {
+		w.SetResyncPeriod(cfg.K8sResyncPeriod)
+		w.SetCacheSyncTimeout(cfg.K8sCacheSyncTimeout)
+	}
+	return watchers, nil
+}
+
+// configUpdater returns a watcher callback that applies data to the global
+// configuration and attributes the changed fields to source.
+// Rejected updates are already logged by config.UpdateFrom and leave the
+// current configuration in place. Conversion and validation errors name the
+// offending field but not its value, so they are safe to log for Secrets too.
+func configUpdater(source config.ValueSource) func(map[string]string) {
+	return func(data map[string]string) {
+		if err := config.UpdateFrom(source, data); err != nil {
+			log.Warn("Config update not applied",
+				log.Field{Key: "source", Value: source.String()},
+				log.Field{Key: "error", Value: err})
+		}
 	}
 }
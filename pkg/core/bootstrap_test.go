@@ -6,11 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/config"
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/scheduler"
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
 )
 
@@ -27,6 +29,14 @@ func TestInitializeLogging(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestSyncLogger tests flushing the default logger on shutdown.
+// This verifies syncing a stdout that cannot be synced is not reported as an error.
+func TestSyncLogger(t *testing.T) {
+	require.NoError(t, initializeLogging(&config.Config{LogLevel: "info", LogFormat: "json"}))
+
+	assert.NoError(t, syncLogger(context.Background()))
+}
+
 // TestInitializeLogging_InvalidLevel tests error handling for invalid log level.
 // This verifies proper validation of log configuration.
 func TestInitializeLogging_InvalidLevel(t *testing.T) {
@@ -110,6 +120,36 @@ func TestRegisterInitializers_WithoutDatabase(t *testing.T) {
 	// Database initializer should be skipped
 }
 
+// TestWatchConfigChanges_ReconfiguresLogging tests live logging reconfiguration.
+// This verifies LogLevel changes via config.Update reach the global logger.
+func TestWatchConfigChanges_ReconfiguresLogging(t *testing.T) {
+	cfg := &config.Config{
+		ServiceName:        "test-service",
+		BusinessHTTPPort:   8080,
+		BusinessGRPCPort:   9090,
+		HealthCheckPort:    8081,
+		MetricsPort:        9091,
+		LogLevel:           "info",
+		LogFormat:          "json",
+		EnableBusinessHTTP: true,
+		EnableBusinessGRPC: true,
+		EnableHealthCheck:  true,
+		EnableMetrics:      true,
+	}
+	require.NoError(t, initializeLogging(cfg))
+	config.Set(cfg)
+	defer config.Set(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, watchConfigChanges(ctx))
+
+	before := log.Default()
+	require.NoError(t, config.Update(map[string]string{"LOG_LEVEL": "debug"}))
+
+	assert.NotSame(t, before, log.Default(), "Logger should be re-initialized on level change")
+}
+
 // TestRegisterInfraServices tests infrastructure service registration.
 // This verifies metrics and health services are properly registered.
 func TestRegisterInfraServices(t *testing.T) {
@@ -121,7 +161,7 @@ func TestRegisterInfraServices(t *testing.T) {
 	// Initialize logging first (required for services)
 	log.Init("info", "json")
 
-	registerInfraServices(launcher, cfg)
+	newApp(cfg, launcher).registerInfraServices()
 
 	// Services should be registered (no panic or error)
 	assert.NotNil(t, launcher)
@@ -137,11 +177,98 @@ func TestRegisterInfraServices_CustomPort(t *testing.T) {
 
 	log.Init("info", "json")
 
-	registerInfraServices(launcher, cfg)
+	newApp(cfg, launcher).registerInfraServices()
 
 	assert.NotNil(t, launcher)
 }
 
+// K8sConfigWatcher must be registrable with the launcher.
+var _ service.Service = (*config.K8sConfigWatcher)(nil)
+
+// TestRegisterInfraServices_K8sWatchOutsideCluster tests config watcher registration.
+// This verifies an enabled watcher that cannot reach the API server fails registration.
+func TestRegisterInfraServices_K8sWatchOutsideCluster(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	launcher := service.NewLauncher()
+	cfg := &config.Config{
+		HealthCheckPort:      8081,
+		EnableHealthCheck:    true,
+		EnableK8sConfigWatch: true,
+		K8sNamespace:         "default",
+		K8sConfigMapName:     "app-config",
+	}
+
+	log.Init("info", "json")
+
+	err := newApp(cfg, launcher).registerInfraServices()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create kubernetes config watcher")
+}
+
+// TestRegisterInfraServices_K8sWatchKubeconfig tests kubeconfig-file mode.
+// This verifies K8S_KUBECONFIG selects the kubeconfig loader.
+func TestRegisterInfraServices_K8sWatchKubeconfig(t *testing.T) {
+	launcher := service.NewLauncher()
+	cfg := &config.Config{
+		EnableK8sConfigWatch: true,
+		K8sNamespace:         "default",
+		K8sConfigMapName:     "app-config",
+		K8sKubeconfig:        "/nonexistent/kubeconfig",
+	}
+
+	log.Init("info", "json")
+
+	err := newApp(cfg, launcher).registerInfraServices()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load kubeconfig")
+}
+
+// TestRegisterInfraServices_Configz tests registration of the /configz endpoint.
+// This verifies the endpoint can be enabled with or without monitoring ports.
+func TestRegisterInfraServices_Configz(t *testing.T) {
+	log.Init("info", "json")
+
+	for _, cfg := range []*config.Config{
+		{EnableConfigz: true, EnableHealthCheck: true, HealthCheckPort: 8081},
+		{EnableConfigz: true, EnableMetrics: true, MetricsPort: 9091},
+		{EnableConfigz: true},
+	} {
+		err := newApp(cfg, service.NewLauncher()).registerInfraServices()
+		assert.NoError(t, err)
+	}
+}
+
+// TestConfigUpdater_RecordsSource tests the watcher update callback.
+// This verifies applied fields are attributed to the watched resource.
+func TestConfigUpdater_RecordsSource(t *testing.T) {
+	log.Init("info", "json")
+	config.Set(&config.Config{
+		ServiceName:      "test-service",
+		BusinessHTTPPort: 8080,
+		BusinessGRPCPort: 9090,
+		HealthCheckPort:  8081,
+		MetricsPort:      9091,
+		LogLevel:         "info",
+		LogFormat:        "json",
+	})
+	defer func() {
+		config.Set(nil)
+		config.SetSources(nil)
+	}()
+
+	apply := configUpdater(config.ValueSource{Source: config.SourceConfigMap, Origin: "app-config"})
+	apply(map[string]string{"LOG_LEVEL": "debug"})
+	apply(map[string]string{"METRICS_PORT": "not-a-port"}) // rejected and logged
+
+	assert.Equal(t, "debug", config.Get().LogLevel)
+	assert.Equal(t, 9091, config.Get().MetricsPort)
+	assert.Equal(t, "configmap app-config: LOG_LEVEL", config.Sources()["LogLevel"].String())
+}
+
 // mockService is a test implementation of service.Service.
 // Used to verify service lifecycle in Bootstrap tests.
 type mockService struct {
@@ -229,7 +356,7 @@ func TestBootstrap_MinimalConfig(t *testing.T) {
 }
 
 // TestBootstrap_InvalidLogConfig tests error handling for invalid log configuration.
-// This verifies Bootstrap fails fast on configuration errors.
+// This verifies Bootstrap fails fast on configuration errors before initializing logging.
 func TestBootstrap_InvalidLogConfig(t *testing.T) {
 	cfg := &config.Config{
 		ServiceName: "test-service",
@@ -240,7 +367,8 @@ func TestBootstrap_InvalidLogConfig(t *testing.T) {
 	err := Bootstrap(cfg)
 
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to initialize logging")
+	assert.Contains(t, err.Error(), "invalid configuration")
+	assert.Contains(t, err.Error(), "invalid log level")
 }
 
 // TestBootstrap_WithDatabaseDSN tests bootstrap with database configuration.
@@ -329,7 +457,7 @@ func TestBootstrap_ConfigurationPropagation(t *testing.T) {
 		BusinessGRPCPort:   9090,
 		HealthCheckPort:    8081,
 		MetricsPort:        9093,
-		LogLevel:           "invalid", // Will fail at log init
+		LogLevel:           "invalid", // Will fail validation
 		LogFormat:          "json",
 		EnableBusinessHTTP: true,
 		EnableBusinessGRPC: true,
@@ -339,9 +467,9 @@ func TestBootstrap_ConfigurationPropagation(t *testing.T) {
 
 	err := Bootstrap(cfg)
 
-	// Should fail at logging initialization
+	// Should fail at configuration validation, before anything starts
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to initialize logging")
+	assert.Contains(t, err.Error(), "invalid configuration")
 }
 
 // TestBootstrap_ZeroServices tests bootstrap without business services.
@@ -446,8 +574,6 @@ func TestBootstrap_AllLogLevels(t *testing.T) {
 // TestRegisterInitializers_DatabaseConfig tests database config mapping.
 // This verifies database configuration is correctly converted.
 func TestRegisterInitializers_DatabaseConfig(t *testing.T) {
-	launcher := service.NewLauncher()
-
 	tests := []struct {
 		name              string
 		databaseDSN       string
@@ -480,6 +606,7 @@ func TestRegisterInitializers_DatabaseConfig(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			launcher := service.NewLauncher()
 			cfg := &config.Config{
 				DatabaseDSN:          tt.databaseDSN,
 				DatabaseMaxOpenConns: tt.maxOpenConns,
@@ -515,7 +642,7 @@ func TestRegisterInfraServices_BothServices(t *testing.T) {
 				MetricsPort: tt.metricsPort,
 			}
 
-			registerInfraServices(launcher, cfg)
+			newApp(cfg, launcher).registerInfraServices()
 
 			// Verify no panic occurred
 			assert.NotNil(t, launcher)
@@ -523,6 +650,51 @@ func TestRegisterInfraServices_BothServices(t *testing.T) {
 	}
 }
 
+// TestRegisterInfraServices_LauncherMetrics tests exporting launcher metrics.
+// This verifies the launcher's restart metrics are registered with the metrics service.
+func TestRegisterInfraServices_LauncherMetrics(t *testing.T) {
+	log.Init("info", "json")
+
+	launcher := service.NewLauncher()
+	app := newApp(&config.Config{EnableMetrics: true, MetricsPort: 9093}, launcher)
+	require.NoError(t, app.registerInfraServices())
+	require.NotNil(t, app.MetricsService())
+
+	for _, collector := range launcher.Collectors() {
+		var already prometheus.AlreadyRegisteredError
+		err := app.MetricsService().GetRegistry().Register(collector)
+		assert.ErrorAs(t, err, &already)
+	}
+}
+
+// TestRegisterScheduler tests registering the WithScheduler scheduler.
+// This verifies the scheduler runs as a launcher service and its metrics are exported.
+func TestRegisterScheduler(t *testing.T) {
+	log.Init("info", "json")
+
+	launcher := service.NewLauncher()
+	app := newApp(&config.Config{EnableMetrics: true, MetricsPort: 9094}, launcher)
+	require.NoError(t, app.registerScheduler(), "No scheduler is a no-op")
+	assert.Empty(t, launcher.Status())
+
+	sched := scheduler.New()
+	app.opts = newOptions([]Option{WithScheduler(sched)})
+	require.NoError(t, app.registerInfraServices())
+	require.NoError(t, app.registerScheduler())
+
+	var names []string
+	for _, s := range launcher.Status() {
+		names = append(names, s.Name)
+	}
+	assert.Contains(t, names, "scheduler")
+
+	for _, collector := range sched.Collectors() {
+		var already prometheus.AlreadyRegisteredError
+		err := app.MetricsService().GetRegistry().Register(collector)
+		assert.ErrorAs(t, err, &already)
+	}
+}
+
 // TestBootstrap_FullConfigCoverage tests bootstrap with all config fields.
 // This verifies Bootstrap handles complete configuration.
 func TestBootstrap_FullConfigCoverage(t *testing.T) {
@@ -619,7 +791,7 @@ func TestRegisterBusinessServers(t *testing.T) {
 				EnableBusinessGRPC: tt.enableGRPC,
 			}
 
-			err := registerBusinessServers(launcher, cfg)
+			err := newApp(cfg, launcher).registerBusinessServers()
 			assert.NoError(t, err)
 		})
 	}
@@ -640,7 +812,7 @@ func TestRegisterBusinessServers_ErrorHandling(t *testing.T) {
 
 	// This should not panic
 	assert.NotPanics(t, func() {
-		registerBusinessServers(nil, cfg)
+		newApp(cfg, nil).registerBusinessServers()
 	})
 }
 
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/scheduler"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
+)
+
+// Option customizes how BootstrapWithOptions assembles the application.
+type Option func(*options)
+
+// options holds the settings collected from Option values.
+type options struct {
+	// services are additional business services to run
+	services []service.Service
+
+	// scheduler runs periodic jobs; nil if none was given
+	scheduler *scheduler.Scheduler
+
+	// setups are run in order against the assembled App before launch
+	setups []func(*App) error
+
+	// reloads are run in order when the App reloads its configuration
+	reloads []func(context.Context) error
+}
+
+// newOptions applies opts over the default settings.
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// WithServices adds business services to run alongside the built-in
+// servers. It may be given several times; services are registered in order.
+//
+// Parameters:
+//   - svcs: Application-specific services to run
+//
+// Returns:
+//   - Option: Option for BootstrapWithOptions
+//
+// Example:
+//
+//	core.BootstrapWithOptions(ctx, cfg, core.WithServices(worker, consumer))
+func WithServices(svcs ...service.Service) Option {
+	return func(o *options) {
+		o.services = append(o.services, svcs...)
+	}
+}
+
+// WithScheduler registers a job scheduler as the "scheduler" service and
+// exports its run metrics through the metrics service when it is enabled.
+// If given several times, the last scheduler wins.
+//
+// Parameters:
+//   - sched: Scheduler with its jobs; jobs may also be added later
+//
+// Returns:
+//   - Option: Option for BootstrapWithOptions
+//
+// Example:
+//
+//	sched := scheduler.New()
+//	sched.AddCronJob("purge-sessions", "*/10 * * * *", purgeSessions)
+//	core.BootstrapWithOptions(ctx, cfg, core.WithScheduler(sched))
+func WithScheduler(sched *scheduler.Scheduler) Option {
+	return func(o *options) {
+		o.scheduler = sched
+	}
+}
+
+// WithSetup adds a function that configures the assembled application before
+// any service starts. Setup functions run in the order given, after the
+// business servers, monitoring services and WithServices services have been
+// registered. Use it to register HTTP routes, gRPC services, health checkers
+// and metrics collectors. An error aborts the bootstrap. Calling app.Run
+// from a setup function fails, and calling app.Shutdown aborts the build.
+//
+// Parameters:
+//   - fn: Function receiving the application handle
+//
+// Returns:
+//   - Option: Option for BootstrapWithOptions
+//
+// Example:
+//
+//	core.WithSetup(func(app *core.App) error {
+//	    // RequestID is installed first, so these log the request ID
+//	    app.HTTPServer().Use(server.Recovery(), server.AccessLog())
+//	    app.HTTPServer().HandleFunc("/api/v1/users", userHandler)
+//	    pb.RegisterUserServiceServer(app.GRPCServer().GetServer(), userService)
+//	    app.AddHealthChecker(dbChecker)
+//	    return app.RegisterCollector(requestCounter)
+//	})
+func WithSetup(fn func(*App) error) Option {
+	return func(o *options) {
+		if fn != nil {
+			o.setups = append(o.setups, fn)
+		}
+	}
+}
+
+// WithReload adds a function that runs when the application reloads its
+// configuration, on SIGHUP or through App.Reload. Reload functions run in
+// the order given, after the Kubernetes config watchers have re-read their
+// resources. Errors are logged and do not stop the application.
+//
+// Parameters:
+//   - fn: Function reloading application-specific configuration
+//
+// Returns:
+//   - Option: Option for BootstrapWithOptions
+//
+// Example:
+//
+//	core.WithReload(func(ctx context.Context) error {
+//	    return featureFlags.Refresh(ctx)
+//	})
+func WithReload(fn func(ctx context.Context) error) Option {
+	return func(o *options) {
+		if fn != nil {
+			o.reloads = append(o.reloads, fn)
+		}
+	}
+}
@@ -204,6 +204,38 @@ func Close() error {
 	return nil
 }
 
+// SetPoolSize adjusts the connection pool limits of the global database connection.
+// This allows pool sizes to be changed at runtime, for example in response to
+// a configuration reload. It is a no-op if no connection has been established.
+//
+// Parameters:
+//   - maxOpenConns: Maximum number of open connections (0 means unlimited)
+//   - maxIdleConns: Maximum number of idle connections
+//
+// Returns:
+//   - error: Returns error if the underlying sql.DB cannot be accessed
+//
+// Thread Safety: Safe for concurrent access.
+func SetPoolSize(maxOpenConns, maxIdleConns int) error {
+	db := GetDB()
+	if db == nil {
+		return nil
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(maxOpenConns)
+	sqlDB.SetMaxIdleConns(maxIdleConns)
+
+	log.Info("Database connection pool resized",
+		log.Field{Key: "max_open_conns", Value: maxOpenConns},
+		log.Field{Key: "max_idle_conns", Value: maxIdleConns})
+	return nil
+}
+
 // parseLogLevel converts string log level to GORM logger level.
 func parseLogLevel(level string) logger.LogLevel {
 	switch level {
@@ -133,6 +133,16 @@ func TestClose_WithNilDB(t *testing.T) {
 	assert.NoError(t, err, "Close should succeed with nil database")
 }
 
+// TestSetPoolSize_WithNilDB tests that SetPoolSize is a no-op without a connection.
+// This is an isolated method test with no external dependencies.
+func TestSetPoolSize_WithNilDB(t *testing.T) {
+	SetDB(nil)
+
+	err := SetPoolSize(50, 5)
+
+	assert.NoError(t, err)
+}
+
 // TestParseLogLevel_ValidLevels tests log level parsing.
 // This is an isolated test of the internal parseLogLevel function.
 func TestParseLogLevel_ValidLevels(t *testing.T) {
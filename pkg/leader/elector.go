@@ -0,0 +1,553 @@
+// Package leader provides Kubernetes Lease based leader election for
+// EggyByte services. It runs a wrapped service.Service on exactly one
+// replica at a time, such as a periodic sweeper that must not run
+// concurrently.
+//
+// Example Usage:
+//
+//	elector, err := leader.NewElector("default", "order-sweeper", sweeper)
+//	if err != nil {
+//	    log.Fatal("Failed to create leader elector", log.Field{Key: "error", Value: err})
+//	}
+//	app.AddNamedService("order-sweeper", elector)
+//	app.AddHealthChecker(elector)
+package leader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/service"
+)
+
+const (
+	// defaultLeaseDuration is how long followers wait before taking over an
+	// unrenewed lease.
+	defaultLeaseDuration = 15 * time.Second
+
+	// defaultRenewDeadline is how long the leader retries renewing before
+	// giving up leadership.
+	defaultRenewDeadline = 10 * time.Second
+
+	// defaultRetryPeriod is the interval between acquire and renew attempts.
+	defaultRetryPeriod = 2 * time.Second
+
+	// defaultStopTimeout bounds stopping the wrapped service on lease loss.
+	defaultStopTimeout = 10 * time.Second
+)
+
+// Elector runs a wrapped service only while this replica holds a
+// Kubernetes Lease. Replicas that do not hold the lease keep campaigning
+// and take over once the leader releases it or fails to renew it.
+//
+// When the lease is acquired the wrapped service is started; when it is
+// lost the wrapped service is stopped and the elector campaigns again.
+// On Stop the wrapped service is stopped before the lease is released, so
+// the next leader never overlaps with this one.
+//
+// If the wrapped service returns while leading, the lease is released and
+// Start returns the service's result, so launcher restart policies apply.
+//
+// Implements the service.Service interface for registration with a
+// launcher, and the monitoring.HealthChecker and monitoring.HealthDetailer
+// interfaces for reporting leadership in /readyz.
+//
+// Required Permissions:
+//   - The service account must have 'get', 'create' and 'update'
+//     permissions on Leases in the coordination.k8s.io API group
+type Elector struct {
+	client    kubernetes.Interface
+	namespace string
+	leaseName string
+	svc       service.Service
+	logger    log.Logger
+	metrics   *electorMetrics
+
+	// identity, the timings and stopTimeout are set before Start
+	identity      string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	stopTimeout   time.Duration
+
+	// mu protects the fields below
+	mu sync.Mutex
+
+	// cancel stops campaigning; nil while Start is not running
+	cancel context.CancelFunc
+
+	// done is closed when Start returns
+	done chan struct{}
+
+	// leader is the identity of the latest observed lease holder
+	leader string
+
+	// current is the wrapped service's run while this replica leads
+	current *term
+}
+
+// term is a run of the wrapped service during one leadership term.
+type term struct {
+	// cancel cancels the context passed to the service's Start
+	cancel context.CancelFunc
+
+	// exited is closed once the service's Start has returned
+	exited chan struct{}
+
+	// stopOnce guards stopping the service
+	stopOnce sync.Once
+
+	// stopped is closed once stopping has begun
+	stopped chan struct{}
+}
+
+// NewElector creates an elector for the named Lease using the in-cluster
+// service account. The identity defaults to the hostname, which is the pod
+// name in Kubernetes.
+//
+// Parameters:
+//   - namespace: Kubernetes namespace of the Lease
+//   - leaseName: Name of the Lease; replicas sharing it elect one leader
+//   - svc: Service to run while leading
+//
+// Returns:
+//   - *Elector: Configured elector
+//   - error: Returns error if the Kubernetes client cannot be created
+//
+// Example:
+//
+//	elector, err := leader.NewElector("default", "order-sweeper", sweeper)
+func NewElector(namespace, leaseName string, svc service.Service) (*Elector, error) {
+	kubeConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create in-cluster config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes clientset: %w", err)
+	}
+
+	return NewElectorWithClient(clientset, namespace, leaseName, svc), nil
+}
+
+// NewElectorWithClient creates an elector backed by the provided
+// Kubernetes client, such as client-go's fake clientset in unit tests.
+//
+// Parameters:
+//   - client: Kubernetes client used to manage the Lease
+//   - namespace: Kubernetes namespace of the Lease
+//   - leaseName: Name of the Lease; replicas sharing it elect one leader
+//   - svc: Service to run while leading
+//
+// Returns:
+//   - *Elector: Configured elector
+//
+// Example:
+//
+//	client := fake.NewSimpleClientset()
+//	elector := leader.NewElectorWithClient(client, "default", "order-sweeper", sweeper)
+//	elector.SetIdentity("pod-a")
+func NewElectorWithClient(client kubernetes.Interface, namespace, leaseName string, svc service.Service) *Elector {
+	identity, err := os.Hostname()
+	if err != nil || identity == "" {
+		identity = fmt.Sprintf("pid-%d", os.Getpid())
+	}
+
+	e := &Elector{
+		client:        client,
+		namespace:     namespace,
+		leaseName:     leaseName,
+		svc:           svc,
+		logger:        log.Default(),
+		metrics:       sharedMetrics,
+		identity:      identity,
+		leaseDuration: defaultLeaseDuration,
+		renewDeadline: defaultRenewDeadline,
+		retryPeriod:   defaultRetryPeriod,
+		stopTimeout:   defaultStopTimeout,
+	}
+	// Export the lease's series from the start without resetting them,
+	// as another Elector in the process may share the lease
+	e.metrics.isLeader.WithLabelValues(leaseName)
+	e.metrics.transitions.WithLabelValues(leaseName)
+	return e
+}
+
+// SetIdentity configures the identity recorded in the Lease while this
+// replica leads. Identities must be unique among the replicas.
+// Must be called before Start.
+//
+// Parameters:
+//   - identity: Unique replica identity
+//
+// Default: the hostname
+func (e *Elector) SetIdentity(identity string) {
+	e.identity = identity
+}
+
+// SetTimings configures the lease timings. The lease duration must exceed
+// the renew deadline, which must exceed the retry period.
+// Must be called before Start.
+//
+// Parameters:
+//   - leaseDuration: How long followers wait before taking over an unrenewed lease
+//   - renewDeadline: How long the leader retries renewing before giving up leadership
+//   - retryPeriod: Interval between acquire and renew attempts
+//
+// Default: 15s lease duration, 10s renew deadline, 2s retry period
+func (e *Elector) SetTimings(leaseDuration, renewDeadline, retryPeriod time.Duration) {
+	e.leaseDuration = leaseDuration
+	e.renewDeadline = renewDeadline
+	e.retryPeriod = retryPeriod
+}
+
+// SetStopTimeout configures how long the wrapped service is given to stop
+// after the lease is lost. Must be called before Start.
+//
+// Parameters:
+//   - timeout: Stop budget of the wrapped service
+//
+// Default: 10 seconds
+func (e *Elector) SetStopTimeout(timeout time.Duration) {
+	e.stopTimeout = timeout
+}
+
+// SetLogger configures a custom logger for leader election messages.
+//
+// Parameters:
+//   - logger: Logger instance to use
+func (e *Elector) SetLogger(logger log.Logger) {
+	e.logger = logger
+}
+
+// Start campaigns for the Lease and runs the wrapped service while this
+// replica holds it. This method blocks until ctx is canceled, Stop is
+// called, or the wrapped service returns while leading.
+//
+// Parameters:
+//   - ctx: Context for cancellation; canceling it stops the wrapped service
+//     and releases the lease
+//
+// Returns:
+//   - error: Returns error if the elector is misconfigured or already
+//     started, or the wrapped service's error if it failed while leading
+func (e *Elector) Start(ctx context.Context) error {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{Name: e.leaseName, Namespace: e.namespace},
+		Client:    e.client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: e.identity,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	e.mu.Lock()
+	if e.cancel != nil {
+		e.mu.Unlock()
+		return fmt.Errorf("leader elector %s already started", e.leaseName)
+	}
+	e.cancel = cancel
+	e.done = make(chan struct{})
+	done := e.done
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		e.cancel = nil
+		e.mu.Unlock()
+		close(done)
+	}()
+
+	e.logger.Info("Campaigning for leadership",
+		log.Field{Key: "lease", Value: e.namespace + "/" + e.leaseName},
+		log.Field{Key: "identity", Value: e.identity})
+
+	for {
+		finished, err := e.campaign(ctx, lock)
+		if finished || err != nil || ctx.Err() != nil {
+			return err
+		}
+		e.logger.Warn("Leadership lost, campaigning again",
+			log.Field{Key: "lease", Value: e.leaseName})
+	}
+}
+
+// campaign runs one election: it waits to acquire the lease, runs the
+// wrapped service while leading and returns once leadership ends.
+// finished reports whether the wrapped service returned on its own.
+func (e *Elector) campaign(ctx context.Context, lock resourcelock.Interface) (bool, error) {
+	electCtx, stopElecting := context.WithCancel(ctx)
+	defer stopElecting()
+
+	// mu guards the results and whether the service may still be started
+	var (
+		mu       sync.Mutex
+		over     bool
+		leading  bool
+		finished bool
+		result   error
+		ended    = make(chan struct{})
+	)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		Name:            e.leaseName,
+		LeaseDuration:   e.leaseDuration,
+		RenewDeadline:   e.renewDeadline,
+		RetryPeriod:     e.retryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				mu.Lock()
+				if over {
+					mu.Unlock()
+					return
+				}
+				leading = true
+				mu.Unlock()
+				defer close(ended)
+
+				f, err := e.lead(leaderCtx)
+				mu.Lock()
+				finished, result = f, err
+				mu.Unlock()
+				if f {
+					// Release the lease now that the service has exited
+					stopElecting()
+				}
+			},
+			OnStoppedLeading: func() {},
+			OnNewLeader:      e.observeLeader,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create leader elector: %w", err)
+	}
+
+	elector.Run(electCtx)
+
+	// Run returns once leadership ends; wait for the service to stop
+	mu.Lock()
+	over = true
+	wasLeading := leading
+	mu.Unlock()
+	if wasLeading {
+		<-ended
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return finished, result
+}
+
+// lead runs the wrapped service until it returns or leaderCtx is canceled
+// because leadership ended. finished reports whether the service returned
+// on its own; err is its error.
+func (e *Elector) lead(leaderCtx context.Context) (finished bool, err error) {
+	svcCtx, cancel := context.WithCancel(leaderCtx)
+	t := &term{cancel: cancel, exited: make(chan struct{}), stopped: make(chan struct{})}
+	e.setTerm(t)
+	defer e.setTerm(nil)
+
+	e.logger.Info("Acquired leadership, starting service",
+		log.Field{Key: "lease", Value: e.leaseName},
+		log.Field{Key: "identity", Value: e.identity})
+
+	result := make(chan error, 1)
+	go func() {
+		defer close(t.exited)
+		result <- e.svc.Start(svcCtx)
+	}()
+
+	select {
+	case err := <-result:
+		if t.isStopped() || leaderCtx.Err() != nil {
+			// Stopped by Stop or because leadership ended
+			return false, nil
+		}
+		t.cancel()
+		if err != nil {
+			e.logger.Error("Service failed while leading",
+				log.Field{Key: "lease", Value: e.leaseName},
+				log.Field{Key: "error", Value: err})
+			return true, fmt.Errorf("leader service %s failed: %w", e.leaseName, err)
+		}
+		return true, nil
+	case <-leaderCtx.Done():
+		e.logger.Warn("Leadership ended, stopping service",
+			log.Field{Key: "lease", Value: e.leaseName})
+		stopCtx, cancel := context.WithTimeout(context.Background(), e.stopTimeout)
+		defer cancel()
+		if err := e.stopTerm(stopCtx, t); err != nil {
+			e.logger.Error("Failed to stop service after leadership ended",
+				log.Field{Key: "lease", Value: e.leaseName},
+				log.Field{Key: "error", Value: err})
+		}
+		return false, nil
+	}
+}
+
+// isStopped reports whether stopping the term's service has begun.
+func (t *term) isStopped() bool {
+	select {
+	case <-t.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopTerm stops the wrapped service of t and waits for its Start to
+// return, within ctx.
+func (e *Elector) stopTerm(ctx context.Context, t *term) error {
+	var err error
+	t.stopOnce.Do(func() {
+		close(t.stopped)
+		err = e.svc.Stop(ctx)
+		t.cancel()
+	})
+
+	select {
+	case <-t.exited:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("service did not stop: %w", ctx.Err())
+	}
+}
+
+// Stop stops the wrapped service if this replica leads, then releases the
+// lease and stops campaigning.
+//
+// Parameters:
+//   - ctx: Context bounding how long to wait
+//
+// Returns:
+//   - error: Returns error if the wrapped service fails to stop or ctx
+//     expires first
+func (e *Elector) Stop(ctx context.Context) error {
+	e.mu.Lock()
+	t, cancel, done := e.current, e.cancel, e.done
+	e.mu.Unlock()
+
+	var err error
+	if t != nil {
+		err = e.stopTerm(ctx, t)
+	}
+	if cancel == nil {
+		return err
+	}
+	cancel()
+
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("leader elector %s did not stop: %w", e.leaseName, ctx.Err())
+	}
+}
+
+// IsLeader reports whether this replica currently holds the lease and
+// runs the wrapped service.
+//
+// Returns:
+//   - bool: True while leading
+func (e *Elector) IsLeader() bool {
+	return e.leading()
+}
+
+// Leader returns the identity of the latest observed lease holder, or an
+// empty string if none has been observed yet.
+//
+// Returns:
+//   - string: Identity of the current leader
+func (e *Elector) Leader() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.leader
+}
+
+// Name returns the health checker identifier for this elector.
+//
+// Returns:
+//   - string: Identifier including the lease name
+func (e *Elector) Name() string {
+	return "leader/" + e.leaseName
+}
+
+// Check reports whether the elector is campaigning or leading. Followers
+// are healthy; leadership is reported through Details.
+//
+// Parameters:
+//   - ctx: Context for the health check (unused)
+//
+// Returns:
+//   - error: Returns error if the elector is not running
+func (e *Elector) Check(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel == nil {
+		return fmt.Errorf("leader election not running")
+	}
+	return nil
+}
+
+// Details describes this replica's role for /readyz.
+//
+// Returns:
+//   - string: "leader", or "follower" with the current leader's identity
+func (e *Elector) Details() string {
+	if e.leading() {
+		return "leader"
+	}
+	if leader := e.Leader(); leader != "" {
+		return "follower; leader is " + leader
+	}
+	return "follower"
+}
+
+// leading reports whether the wrapped service runs for a leadership term.
+func (e *Elector) leading() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.current != nil
+}
+
+// setTerm records the current leadership term, or nil once it has ended.
+func (e *Elector) setTerm(t *term) {
+	e.mu.Lock()
+	e.current = t
+	e.mu.Unlock()
+
+	if t != nil {
+		e.metrics.isLeader.WithLabelValues(e.leaseName).Set(1)
+		e.metrics.transitions.WithLabelValues(e.leaseName).Inc()
+		return
+	}
+	e.metrics.isLeader.WithLabelValues(e.leaseName).Set(0)
+}
+
+// observeLeader records a newly observed lease holder.
+func (e *Elector) observeLeader(identity string) {
+	e.mu.Lock()
+	e.leader = identity
+	e.mu.Unlock()
+
+	if identity != e.identity {
+		e.logger.Info("Observed new leader",
+			log.Field{Key: "lease", Value: e.leaseName},
+			log.Field{Key: "leader", Value: identity})
+	}
+}
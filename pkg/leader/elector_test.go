@@ -0,0 +1,243 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// workerService counts its starts and stops and blocks in Start until
+// canceled, unless startErr is set.
+type workerService struct {
+	starts   atomic.Int32
+	stops    atomic.Int32
+	running  atomic.Bool
+	startErr error
+}
+
+func (w *workerService) Start(ctx context.Context) error {
+	w.starts.Add(1)
+	if w.startErr != nil {
+		return w.startErr
+	}
+	w.running.Store(true)
+	defer w.running.Store(false)
+	<-ctx.Done()
+	return nil
+}
+
+func (w *workerService) Stop(ctx context.Context) error {
+	w.stops.Add(1)
+	return nil
+}
+
+// newTestElector returns an elector with short lease timings.
+func newTestElector(client kubernetes.Interface, identity string, svc *workerService) *Elector {
+	e := NewElectorWithClient(client, "default", "sweeper", svc)
+	e.SetIdentity(identity)
+	e.SetTimings(time.Second, 500*time.Millisecond, 50*time.Millisecond)
+	return e
+}
+
+// startElector runs e.Start in the background and returns its result channel.
+func startElector(e *Elector) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- e.Start(context.Background()) }()
+	return done
+}
+
+// leaseHolder returns the holder identity recorded in the Lease.
+func leaseHolder(t *testing.T, client kubernetes.Interface) string {
+	t.Helper()
+	lease, err := client.CoordinationV1().Leases("default").Get(context.Background(), "sweeper", metav1.GetOptions{})
+	require.NoError(t, err)
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+// TestElector_LeadsAndReleases tests a single replica acquiring the lease.
+// This verifies the wrapped service runs while leading and the lease is released on Stop.
+func TestElector_LeadsAndReleases(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	svc := &workerService{}
+	e := newTestElector(client, "pod-a", svc)
+
+	assert.Error(t, e.Check(context.Background()), "Check fails before Start")
+	assert.Equal(t, float64(0), testutil.ToFloat64(e.metrics.isLeader.WithLabelValues("sweeper")))
+	// The metrics are shared by every test Elector, so compare against the start value
+	transitions := testutil.ToFloat64(e.metrics.transitions.WithLabelValues("sweeper"))
+
+	done := startElector(e)
+	require.Eventually(t, svc.running.Load, 3*time.Second, 10*time.Millisecond)
+
+	assert.True(t, e.IsLeader())
+	assert.Equal(t, "leader", e.Details())
+	assert.NoError(t, e.Check(context.Background()))
+	assert.Equal(t, "pod-a", leaseHolder(t, client))
+	assert.Equal(t, float64(1), testutil.ToFloat64(e.metrics.isLeader.WithLabelValues("sweeper")))
+	assert.Equal(t, transitions+1, testutil.ToFloat64(e.metrics.transitions.WithLabelValues("sweeper")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	require.NoError(t, e.Stop(ctx))
+	require.NoError(t, <-done)
+
+	assert.False(t, svc.running.Load())
+	assert.Equal(t, int32(1), svc.stops.Load())
+	assert.False(t, e.IsLeader())
+	assert.Equal(t, "", leaseHolder(t, client), "Lease should be released")
+	assert.Equal(t, float64(0), testutil.ToFloat64(e.metrics.isLeader.WithLabelValues("sweeper")))
+}
+
+// TestElector_CollectorsShareRegistry tests exporting several Electors' metrics.
+// This verifies Electors for different leases register on one registry and each exports its lease.
+func TestElector_CollectorsShareRegistry(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sweeper := newTestElector(client, "pod-a", &workerService{})
+	reporter := NewElectorWithClient(client, "default", "reporter", &workerService{})
+
+	registry := prometheus.NewRegistry()
+	for _, e := range []*Elector{sweeper, reporter} {
+		for _, c := range e.Collectors() {
+			require.NoError(t, registry.Register(c))
+		}
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	leases := make(map[string][]string)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				leases[family.GetName()] = append(leases[family.GetName()], label.GetValue())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"sweeper", "reporter"}, leases["eggybyte_leader_is_leader"])
+	assert.ElementsMatch(t, []string{"sweeper", "reporter"}, leases["eggybyte_leader_transitions_total"])
+}
+
+// TestElector_Failover tests two replicas sharing a lease.
+// This verifies only one runs the service and the follower takes over once the leader stops.
+func TestElector_Failover(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	svcA, svcB := &workerService{}, &workerService{}
+	a := newTestElector(client, "pod-a", svcA)
+	b := newTestElector(client, "pod-b", svcB)
+
+	doneA := startElector(a)
+	require.Eventually(t, svcA.running.Load, 3*time.Second, 10*time.Millisecond)
+	doneB := startElector(b)
+
+	require.Eventually(t, func() bool { return b.Leader() == "pod-a" }, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "follower; leader is pod-a", b.Details())
+	assert.NoError(t, b.Check(context.Background()), "Followers are healthy")
+	assert.Equal(t, int32(0), svcB.starts.Load())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	require.NoError(t, a.Stop(ctx))
+	require.NoError(t, <-doneA)
+
+	require.Eventually(t, svcB.running.Load, 3*time.Second, 10*time.Millisecond)
+	assert.True(t, b.IsLeader())
+	assert.Equal(t, "pod-b", leaseHolder(t, client))
+
+	require.NoError(t, b.Stop(ctx))
+	require.NoError(t, <-doneB)
+}
+
+// TestElector_LeaseLost tests losing the lease to another holder.
+// This verifies the wrapped service is stopped and the elector keeps campaigning.
+func TestElector_LeaseLost(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	svc := &workerService{}
+	e := newTestElector(client, "pod-a", svc)
+
+	done := startElector(e)
+	require.Eventually(t, svc.running.Load, 3*time.Second, 10*time.Millisecond)
+
+	// Another replica takes the lease, e.g. after a network partition
+	lease, err := client.CoordinationV1().Leases("default").Get(context.Background(), "sweeper", metav1.GetOptions{})
+	require.NoError(t, err)
+	intruder, duration := "pod-z", int32(60)
+	lease.Spec = coordinationv1.LeaseSpec{
+		HolderIdentity:       &intruder,
+		LeaseDurationSeconds: &duration,
+		AcquireTime:          &metav1.MicroTime{Time: time.Now()},
+		RenewTime:            &metav1.MicroTime{Time: time.Now()},
+	}
+	_, err = client.CoordinationV1().Leases("default").Update(context.Background(), lease, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// The fake clientset ignores resource versions, so reject the old
+	// leader's writes the way the API server would
+	client.PrependReactor("update", "leases", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(coordinationv1.Resource("leases"), "sweeper", errors.New("lease held by pod-z"))
+	})
+
+	require.Eventually(t, func() bool { return !svc.running.Load() }, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), svc.stops.Load())
+	assert.False(t, e.IsLeader())
+	require.Eventually(t, func() bool { return e.Leader() == "pod-z" }, 3*time.Second, 10*time.Millisecond)
+
+	select {
+	case err := <-done:
+		t.Fatalf("Start returned after losing the lease: %v", err)
+	default:
+	}
+	assert.NoError(t, e.Check(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	require.NoError(t, e.Stop(ctx))
+	require.NoError(t, <-done)
+	assert.Equal(t, "pod-z", leaseHolder(t, client), "A lease held by another replica is not released")
+}
+
+// TestElector_ServiceFailure tests the wrapped service failing while leading.
+// This verifies Start returns the error and the lease is released for another replica.
+func TestElector_ServiceFailure(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	svc := &workerService{startErr: errors.New("sweep failed")}
+	e := newTestElector(client, "pod-a", svc)
+
+	select {
+	case err := <-startElector(e):
+		require.Error(t, err)
+		assert.ErrorIs(t, err, svc.startErr)
+	case <-time.After(3 * time.Second):
+		t.Fatal("Start did not return after the wrapped service failed")
+	}
+
+	assert.Equal(t, int32(1), svc.starts.Load())
+	assert.Equal(t, "", leaseHolder(t, client))
+	assert.Error(t, e.Check(context.Background()))
+}
+
+// TestElector_InvalidTimings tests lease timings rejected by client-go.
+// This verifies Start reports the configuration error.
+func TestElector_InvalidTimings(t *testing.T) {
+	e := newTestElector(fake.NewSimpleClientset(), "pod-a", &workerService{})
+	e.SetTimings(time.Second, 2*time.Second, 100*time.Millisecond)
+
+	err := e.Start(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create leader elector")
+}
@@ -0,0 +1,75 @@
+package leader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// electorMetrics holds the Prometheus metrics exported by Electors.
+// They are not registered anywhere by default; see Elector.Collectors.
+type electorMetrics struct {
+	// isLeader is 1 while this replica leads the lease, 0 otherwise
+	isLeader *prometheus.GaugeVec
+
+	// transitions counts the times this replica acquired the lease
+	transitions *prometheus.CounterVec
+}
+
+// sharedMetrics holds the series of every Elector in the process, one per
+// lease name. Defining the vectors once keeps Electors from registering
+// collectors with clashing descriptors.
+var sharedMetrics = newElectorMetrics()
+
+// newElectorMetrics creates the elector metrics.
+func newElectorMetrics() *electorMetrics {
+	return &electorMetrics{
+		isLeader: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "eggybyte",
+			Subsystem: "leader",
+			Name:      "is_leader",
+			Help:      "1 while this replica holds the lease and runs the wrapped service, 0 otherwise.",
+		}, []string{"lease"}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "leader",
+			Name:      "transitions_total",
+			Help:      "Number of times this replica acquired the lease.",
+		}, []string{"lease"}),
+	}
+}
+
+// leaseCollector exports the shared series of a single lease. It describes
+// no metrics, which makes it an unchecked collector: registries accept one
+// per Elector although they all export the same metric names.
+type leaseCollector struct {
+	metrics *electorMetrics
+	lease   string
+}
+
+// Describe implements prometheus.Collector. It sends nothing.
+func (c *leaseCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *leaseCollector) Collect(ch chan<- prometheus.Metric) {
+	c.metrics.isLeader.WithLabelValues(c.lease).Collect(ch)
+	c.metrics.transitions.WithLabelValues(c.lease).Collect(ch)
+}
+
+// Collectors returns the elector's Prometheus collectors: whether this
+// replica leads and how often it acquired the lease. Register them with a
+// metrics registry to export them.
+//
+// The collectors export only this Elector's lease, so the collectors of
+// Electors for different leases can be registered with the same registry.
+// Register only one Elector per lease name.
+//
+// Returns:
+//   - []prometheus.Collector: Collectors to register
+//
+// Example:
+//
+//	for _, c := range elector.Collectors() {
+//	    app.RegisterCollector(c)
+//	}
+func (e *Elector) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{&leaseCollector{metrics: e.metrics, lease: e.leaseName}}
+}
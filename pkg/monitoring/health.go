@@ -29,7 +29,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,6 +47,92 @@ type HealthChecker interface {
 	Check(ctx context.Context) error
 }
 
+// HealthDetailer is implemented by health checkers that report details
+// alongside their result, such as which replica holds a leader lease.
+// Details are included in /readyz and /healthz responses under "details",
+// whether the check passes or not.
+type HealthDetailer interface {
+	// Details returns a short human-readable description of the
+	// checker's current state.
+	Details() string
+}
+
+// routeMux is the request router of a monitoring server. Built-in endpoints
+// are registered when it is created; additional endpoints are validated and
+// registered when added, so they are served whether the server is running
+// or not.
+type routeMux struct {
+	// mux routes requests; http.ServeMux allows registering while serving
+	mux *http.ServeMux
+
+	// builtin holds the paths of the built-in endpoints
+	builtin map[string]bool
+
+	// patterns lists all registered patterns in registration order
+	patterns []string
+
+	// mu protects builtin and patterns
+	mu sync.Mutex
+}
+
+// newRouteMux creates a router without endpoints.
+func newRouteMux() *routeMux {
+	return &routeMux{mux: http.NewServeMux(), builtin: make(map[string]bool)}
+}
+
+// handleBuiltin registers a built-in endpoint that Handle cannot replace.
+func (m *routeMux) handleBuiltin(pattern string, handler http.Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mux.Handle(pattern, handler)
+	m.builtin[pattern] = true
+	m.patterns = append(m.patterns, pattern)
+}
+
+// handle registers an additional endpoint. Returns an error instead of
+// panicking like http.ServeMux when the pattern is invalid, replaces a
+// built-in endpoint or conflicts with a registered one.
+func (m *routeMux) handle(pattern string, handler http.Handler) (err error) {
+	if handler == nil {
+		return fmt.Errorf("nil handler for pattern %q", pattern)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if path := patternPath(pattern); m.builtin[path] {
+		return fmt.Errorf("pattern %q conflicts with built-in endpoint %s", pattern, path)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("cannot register pattern %q: %v", pattern, p)
+		}
+	}()
+	m.mux.Handle(pattern, handler)
+	m.patterns = append(m.patterns, pattern)
+	return nil
+}
+
+// endpoints returns the registered patterns, built-in endpoints first.
+func (m *routeMux) endpoints() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.patterns...)
+}
+
+// patternPath returns the path of an http.ServeMux pattern of the form
+// "[METHOD ][HOST]/[PATH]".
+func patternPath(pattern string) string {
+	if i := strings.IndexAny(pattern, " \t"); i >= 0 {
+		pattern = strings.TrimLeft(pattern[i:], " \t")
+	}
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		return pattern[i:]
+	}
+	return pattern
+}
+
 // HealthService provides Kubernetes-compatible health check endpoints
 // on a dedicated port for security and monitoring isolation.
 //
@@ -73,8 +161,20 @@ type HealthService struct {
 	// mu protects concurrent access to checkers slice
 	mu sync.RWMutex
 
-	// serverMu protects concurrent access to server field
+	// serverMu protects concurrent access to server and listener fields
 	serverMu sync.RWMutex
+
+	// listener is the bound listener, nil until Start binds it
+	listener net.Listener
+
+	// routes serves the built-in endpoints and those registered via Handle
+	routes *routeMux
+
+	// ready is closed once the server is listening
+	ready chan struct{}
+
+	// readyOnce guards closing ready
+	readyOnce sync.Once
 }
 
 // NewHealthService creates a new health check service with the specified port.
@@ -92,11 +192,17 @@ type HealthService struct {
 //	healthService.AddHealthChecker(databaseChecker)
 //	launcher.AddService(healthService)
 func NewHealthService(port int) *HealthService {
-	return &HealthService{
+	h := &HealthService{
 		port:     port,
 		logger:   log.Default(),
 		checkers: make([]HealthChecker, 0),
+		ready:    make(chan struct{}),
 	}
+	h.routes = newRouteMux()
+	h.routes.handleBuiltin("/healthz", http.HandlerFunc(h.handleHealthz))
+	h.routes.handleBuiltin("/livez", http.HandlerFunc(h.handleLivez))
+	h.routes.handleBuiltin("/readyz", http.HandlerFunc(h.handleReadyz))
+	return h
 }
 
 // AddHealthChecker registers a health checker with this service.
@@ -121,6 +227,33 @@ func (h *HealthService) AddHealthChecker(checker HealthChecker) {
 		log.Field{Key: "total_checkers", Value: len(h.checkers)})
 }
 
+// Handle registers an additional endpoint on the health check port,
+// such as the /configz configuration dump. Routes may be registered before
+// or after Start; routes registered while the server runs are served
+// immediately. Built-in endpoints cannot be replaced.
+//
+// Parameters:
+//   - pattern: http.ServeMux pattern (e.g., "/configz")
+//   - handler: Handler serving the endpoint
+//
+// Returns:
+//   - error: Returns error if handler is nil, or if the pattern is invalid,
+//     replaces a built-in endpoint or conflicts with a registered route
+//
+// Thread Safety: This method is safe for concurrent use.
+//
+// Example:
+//
+//	if err := healthService.Handle("/configz", config.Handler()); err != nil {
+//	    return err
+//	}
+func (h *HealthService) Handle(pattern string, handler http.Handler) error {
+	if err := h.routes.handle(pattern, handler); err != nil {
+		return fmt.Errorf("failed to register health check endpoint: %w", err)
+	}
+	return nil
+}
+
 // Start begins the health check HTTP server.
 // This method blocks until the server is stopped or encounters an error.
 // It should be called in a goroutine for non-blocking operation.
@@ -147,19 +280,11 @@ func (h *HealthService) AddHealthChecker(checker HealthChecker) {
 //	}()
 //	defer cancel()
 func (h *HealthService) Start(ctx context.Context) error {
-	// Setup HTTP routes
-	mux := http.NewServeMux()
-
-	// Health check endpoints
-	mux.HandleFunc("/healthz", h.handleHealthz)
-	mux.HandleFunc("/livez", h.handleLivez)
-	mux.HandleFunc("/readyz", h.handleReadyz)
-
 	// Create HTTP server
 	h.serverMu.Lock()
 	h.server = &http.Server{
 		Addr:         fmt.Sprintf(":%d", h.port),
-		Handler:      mux,
+		Handler:      h.routes.mux,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
@@ -168,11 +293,21 @@ func (h *HealthService) Start(ctx context.Context) error {
 
 	h.logger.Info("Starting health check server",
 		log.Field{Key: "port", Value: h.port},
-		log.Field{Key: "endpoints", Value: "/healthz, /livez, /readyz"})
+		log.Field{Key: "endpoints", Value: strings.Join(h.routes.endpoints(), ", ")})
 
 	// Create a channel to receive server errors
 	errChan := make(chan error, 1)
 
+	// Bind before serving so readiness reflects an open port
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", h.port))
+	if err != nil {
+		return fmt.Errorf("health check server failed: %w", err)
+	}
+	h.serverMu.Lock()
+	h.listener = listener
+	h.serverMu.Unlock()
+	h.readyOnce.Do(func() { close(h.ready) })
+
 	// Start server in a goroutine
 	go func() {
 		h.serverMu.RLock()
@@ -180,7 +315,7 @@ func (h *HealthService) Start(ctx context.Context) error {
 		h.serverMu.RUnlock()
 
 		if server != nil {
-			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 				errChan <- fmt.Errorf("health check server failed: %w", err)
 			}
 		}
@@ -244,6 +379,15 @@ func (h *HealthService) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Ready returns a channel that is closed once the server is listening.
+// It implements service.ReadyNotifier.
+//
+// Returns:
+//   - <-chan struct{}: Channel closed when the server accepts connections
+func (h *HealthService) Ready() <-chan struct{} {
+	return h.ready
+}
+
 // GetPort returns the configured port for this service.
 // This method is useful for logging and monitoring purposes.
 //
@@ -253,6 +397,21 @@ func (h *HealthService) GetPort() int {
 	return h.port
 }
 
+// Addr returns the address the service is listening on. Unlike GetPort it
+// reports the port actually bound, which differs when the configured
+// port is 0.
+//
+// Returns:
+//   - net.Addr: The bound address, or nil before the service is listening
+func (h *HealthService) Addr() net.Addr {
+	h.serverMu.RLock()
+	defer h.serverMu.RUnlock()
+	if h.listener == nil {
+		return nil
+	}
+	return h.listener.Addr()
+}
+
 // GetCheckerCount returns the number of registered health checkers.
 // This method is useful for monitoring and debugging purposes.
 //
@@ -301,7 +460,8 @@ func (h *HealthService) handleLivez(w http.ResponseWriter, r *http.Request) {
 //   - Runs all registered health checkers with timeout
 //   - Returns 200 OK if all checkers pass
 //   - Returns 503 Service Unavailable if any checker fails
-//   - Includes detailed results in JSON response
+//   - Includes detailed results in JSON response, plus the details of
+//     checkers implementing HealthDetailer
 func (h *HealthService) handleReadyz(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
@@ -312,6 +472,7 @@ func (h *HealthService) handleReadyz(w http.ResponseWriter, r *http.Request) {
 	h.mu.RUnlock()
 
 	results := make(map[string]string)
+	details := make(map[string]string)
 	healthy := true
 
 	for _, checker := range checkers {
@@ -321,6 +482,9 @@ func (h *HealthService) handleReadyz(w http.ResponseWriter, r *http.Request) {
 		} else {
 			results[checker.Name()] = "OK"
 		}
+		if detailer, ok := checker.(HealthDetailer); ok {
+			details[checker.Name()] = detailer.Details()
+		}
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -331,11 +495,16 @@ func (h *HealthService) handleReadyz(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 	}
 
-	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+	response := map[string]interface{}{
 		"status":    healthy,
 		"checks":    results,
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
-	}); err != nil {
+	}
+	if len(details) > 0 {
+		response["details"] = details
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
 		h.logger.Error("Failed to encode health response", log.Field{Key: "error", Value: err})
 	}
 }
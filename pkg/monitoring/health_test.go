@@ -2,12 +2,15 @@ package monitoring
 
 import (
 	"context"
+	"encoding/json"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // MockHealthChecker is a test implementation of HealthChecker interface
@@ -175,6 +178,43 @@ func TestHealthService_handleReadyz_MultipleCheckers(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "FAIL")
 }
 
+// detailedChecker is a healthy checker that reports details
+type detailedChecker struct {
+	MockHealthChecker
+	details string
+}
+
+func (d *detailedChecker) Details() string {
+	return d.details
+}
+
+func TestHealthService_handleReadyz_Details(t *testing.T) {
+	service := NewHealthService(8081)
+
+	req := httptest.NewRequest("GET", "/readyz", nil)
+	rec := httptest.NewRecorder()
+	service.handleReadyz(rec, req)
+	assert.NotContains(t, rec.Body.String(), "details")
+
+	service.AddHealthChecker(&MockHealthChecker{name: "plain", healthy: true})
+	service.AddHealthChecker(&detailedChecker{
+		MockHealthChecker: MockHealthChecker{name: "leader/sweeper", healthy: true},
+		details:           "follower; leader is pod-b",
+	})
+
+	rec = httptest.NewRecorder()
+	service.handleReadyz(rec, req)
+
+	var body struct {
+		Checks  map[string]string `json:"checks"`
+		Details map[string]string `json:"details"`
+	}
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "OK", body.Checks["leader/sweeper"])
+	assert.Equal(t, map[string]string{"leader/sweeper": "follower; leader is pod-b"}, body.Details)
+}
+
 func TestHealthService_handleHealthz(t *testing.T) {
 	service := NewHealthService(8081)
 
@@ -339,3 +379,94 @@ func TestHealthService_JSONResponse(t *testing.T) {
 	assert.Contains(t, body, "test-checker")
 	assert.Contains(t, body, "OK")
 }
+
+func TestHealthService_Handle(t *testing.T) {
+	service := NewHealthService(8081)
+	require.NoError(t, service.Handle("/configz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("config"))
+	})))
+
+	assert.Equal(t, []string{"/healthz", "/livez", "/readyz", "/configz"}, service.routes.endpoints())
+
+	req := httptest.NewRequest("GET", "/configz", nil)
+	rec := httptest.NewRecorder()
+	service.routes.mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "config", rec.Body.String())
+
+	// Built-in endpoints remain available
+	req = httptest.NewRequest("GET", "/livez", nil)
+	rec = httptest.NewRecorder()
+	service.routes.mux.ServeHTTP(rec, req)
+	assert.Equal(t, "OK", rec.Body.String())
+}
+
+func TestHealthService_HandleRejectsInvalidRoutes(t *testing.T) {
+	service := NewHealthService(8081)
+	handler := http.NotFoundHandler()
+	require.NoError(t, service.Handle("/statusz", handler))
+
+	for _, pattern := range []string{"/healthz", "GET /livez", "localhost/readyz", "/statusz", "", "statusz", "/{bad"} {
+		err := service.Handle(pattern, handler)
+		assert.Error(t, err, "pattern %q", pattern)
+	}
+	assert.Error(t, service.Handle("/nil", nil))
+
+	assert.Equal(t, []string{"/healthz", "/livez", "/readyz", "/statusz"}, service.routes.endpoints())
+}
+
+func TestHealthService_HandleWhileRunning(t *testing.T) {
+	service := NewHealthService(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- service.Start(ctx)
+	}()
+	<-service.Ready()
+
+	require.NoError(t, service.Handle("/late", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})))
+
+	service.serverMu.RLock()
+	server := service.server
+	service.serverMu.RUnlock()
+
+	rec := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rec, httptest.NewRequest("GET", "/late", nil))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+
+	cancel()
+	assert.NoError(t, <-errChan)
+}
+
+func TestHealthService_Ready(t *testing.T) {
+	service := NewHealthService(0)
+
+	select {
+	case <-service.Ready():
+		t.Fatal("Service must not be ready before Start")
+	default:
+	}
+	assert.Nil(t, service.Addr(), "No address before Start")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- service.Start(ctx)
+	}()
+
+	select {
+	case <-service.Ready():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Service did not report ready")
+	}
+	addr, ok := service.Addr().(*net.TCPAddr)
+	require.True(t, ok, "Addr reports the bound TCP address once ready")
+	assert.NotZero(t, addr.Port, "Port 0 resolves to the bound port")
+
+	cancel()
+	assert.NoError(t, <-errChan)
+}
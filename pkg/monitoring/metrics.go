@@ -4,7 +4,9 @@ package monitoring
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,8 +40,20 @@ type MetricsService struct {
 	// registry is the Prometheus metrics registry
 	registry *prometheus.Registry
 
-	// serverMu protects concurrent access to server field
+	// serverMu protects concurrent access to server and listener fields
 	serverMu sync.RWMutex
+
+	// listener is the bound listener, nil until Start binds it
+	listener net.Listener
+
+	// routes serves /metrics and the endpoints registered via Handle
+	routes *routeMux
+
+	// ready is closed once the server is listening
+	ready chan struct{}
+
+	// readyOnce guards closing ready
+	readyOnce sync.Once
 }
 
 // NewMetricsService creates a new metrics exposition service with the specified port.
@@ -69,6 +83,8 @@ func NewMetricsService(port int) *MetricsService {
 		port:     port,
 		logger:   log.Default(),
 		registry: registry,
+		routes:   newMetricsRouteMux(registry),
+		ready:    make(chan struct{}),
 	}
 }
 
@@ -92,6 +108,8 @@ func NewMetricsServiceWithRegistry(port int, registry *prometheus.Registry) *Met
 		port:     port,
 		logger:   log.Default(),
 		registry: registry,
+		routes:   newMetricsRouteMux(registry),
+		ready:    make(chan struct{}),
 	}
 }
 
@@ -151,6 +169,26 @@ func (m *MetricsService) UnregisterCollector(collector prometheus.Collector) boo
 	return success
 }
 
+// Handle registers an additional endpoint on the metrics port. Routes may
+// be registered before or after Start; routes registered while the server
+// runs are served immediately. /metrics cannot be replaced.
+//
+// Parameters:
+//   - pattern: http.ServeMux pattern (e.g., "/configz")
+//   - handler: Handler serving the endpoint
+//
+// Returns:
+//   - error: Returns error if handler is nil, or if the pattern is invalid,
+//     replaces /metrics or conflicts with a registered route
+//
+// Thread Safety: This method is safe for concurrent use.
+func (m *MetricsService) Handle(pattern string, handler http.Handler) error {
+	if err := m.routes.handle(pattern, handler); err != nil {
+		return fmt.Errorf("failed to register metrics endpoint: %w", err)
+	}
+	return nil
+}
+
 // Start begins the metrics HTTP server.
 // This method blocks until the server is stopped or encounters an error.
 // It should be called in a goroutine for non-blocking operation.
@@ -177,20 +215,11 @@ func (m *MetricsService) UnregisterCollector(collector prometheus.Collector) boo
 //	}()
 //	defer cancel()
 func (m *MetricsService) Start(ctx context.Context) error {
-	// Setup HTTP routes
-	mux := http.NewServeMux()
-
-	// Metrics endpoint with custom registry
-	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
-		EnableOpenMetrics: true,
-		Timeout:           10 * time.Second,
-	}))
-
 	// Create HTTP server
 	m.serverMu.Lock()
 	m.server = &http.Server{
 		Addr:         fmt.Sprintf(":%d", m.port),
-		Handler:      mux,
+		Handler:      m.routes.mux,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 30 * time.Second, // Longer timeout for metrics collection
 		IdleTimeout:  120 * time.Second,
@@ -199,11 +228,21 @@ func (m *MetricsService) Start(ctx context.Context) error {
 
 	m.logger.Info("Starting metrics server",
 		log.Field{Key: "port", Value: m.port},
-		log.Field{Key: "endpoints", Value: "/metrics"})
+		log.Field{Key: "endpoints", Value: strings.Join(m.routes.endpoints(), ", ")})
 
 	// Create a channel to receive server errors
 	errChan := make(chan error, 1)
 
+	// Bind before serving so readiness reflects an open port
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", m.port))
+	if err != nil {
+		return fmt.Errorf("metrics server failed: %w", err)
+	}
+	m.serverMu.Lock()
+	m.listener = listener
+	m.serverMu.Unlock()
+	m.readyOnce.Do(func() { close(m.ready) })
+
 	// Start server in a goroutine
 	go func() {
 		m.serverMu.RLock()
@@ -211,7 +250,7 @@ func (m *MetricsService) Start(ctx context.Context) error {
 		m.serverMu.RUnlock()
 
 		if server != nil {
-			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 				errChan <- fmt.Errorf("metrics server failed: %w", err)
 			}
 		}
@@ -247,6 +286,17 @@ func (m *MetricsService) Start(ctx context.Context) error {
 	}
 }
 
+// newMetricsRouteMux builds the request router with the /metrics endpoint
+// serving registry.
+func newMetricsRouteMux(registry *prometheus.Registry) *routeMux {
+	routes := newRouteMux()
+	routes.handleBuiltin("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+		Timeout:           10 * time.Second,
+	}))
+	return routes
+}
+
 // Stop gracefully shuts down the metrics server.
 // This method is provided for compatibility with the Service interface.
 // In practice, shutdown is handled by the Start method when context is canceled.
@@ -275,6 +325,15 @@ func (m *MetricsService) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Ready returns a channel that is closed once the server is listening.
+// It implements service.ReadyNotifier.
+//
+// Returns:
+//   - <-chan struct{}: Channel closed when the server accepts connections
+func (m *MetricsService) Ready() <-chan struct{} {
+	return m.ready
+}
+
 // GetPort returns the configured port for this service.
 // This method is useful for logging and monitoring purposes.
 //
@@ -284,6 +343,21 @@ func (m *MetricsService) GetPort() int {
 	return m.port
 }
 
+// Addr returns the address the service is listening on. Unlike GetPort it
+// reports the port actually bound, which differs when the configured
+// port is 0.
+//
+// Returns:
+//   - net.Addr: The bound address, or nil before the service is listening
+func (m *MetricsService) Addr() net.Addr {
+	m.serverMu.RLock()
+	defer m.serverMu.RUnlock()
+	if m.listener == nil {
+		return nil
+	}
+	return m.listener.Addr()
+}
+
 // GetRegistry returns the Prometheus metrics registry.
 // This method provides access to the underlying registry for advanced use cases.
 //
@@ -2,6 +2,7 @@ package monitoring
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -337,3 +338,45 @@ func TestMetricsService_DefaultCollectors(t *testing.T) {
 	assert.Contains(t, body, "go_")      // Go runtime metrics
 	assert.Contains(t, body, "process_") // Process metrics
 }
+
+func TestMetricsService_Handle(t *testing.T) {
+	service := NewMetricsService(9091)
+	require.NoError(t, service.Handle("/configz", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})))
+
+	assert.Equal(t, []string{"/metrics", "/configz"}, service.routes.endpoints())
+
+	req := httptest.NewRequest("GET", "/configz", nil)
+	rec := httptest.NewRecorder()
+	service.routes.mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+
+	assert.Error(t, service.Handle("/metrics", http.NotFoundHandler()))
+	assert.Error(t, service.Handle("/configz", http.NotFoundHandler()))
+	assert.Equal(t, []string{"/metrics", "/configz"}, service.routes.endpoints())
+}
+
+func TestMetricsService_Ready(t *testing.T) {
+	service := NewMetricsService(0)
+	assert.Nil(t, service.Addr(), "No address before Start")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- service.Start(ctx)
+	}()
+
+	select {
+	case <-service.Ready():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Service did not report ready")
+	}
+	addr, ok := service.Addr().(*net.TCPAddr)
+	require.True(t, ok, "Addr reports the bound TCP address once ready")
+	assert.NotZero(t, addr.Port, "Port 0 resolves to the bound port")
+
+	cancel()
+	assert.NoError(t, <-errChan)
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// schedulerMetrics holds the Prometheus metrics exported by a Scheduler.
+// They are not registered anywhere by default; see Scheduler.Collectors.
+type schedulerMetrics struct {
+	// runs counts completed runs per job
+	runs *prometheus.CounterVec
+
+	// failures counts runs that returned an error or panicked per job
+	failures *prometheus.CounterVec
+
+	// skipped counts activations skipped because the previous run was
+	// still in progress
+	skipped *prometheus.CounterVec
+
+	// duration observes how long each run took
+	duration *prometheus.HistogramVec
+
+	// lastSuccess holds the Unix time of the latest successful run per job
+	lastSuccess *prometheus.GaugeVec
+}
+
+// newSchedulerMetrics creates the scheduler metrics.
+func newSchedulerMetrics() *schedulerMetrics {
+	return &schedulerMetrics{
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "scheduler",
+			Name:      "runs_total",
+			Help:      "Number of completed job runs.",
+		}, []string{"job"}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "scheduler",
+			Name:      "failures_total",
+			Help:      "Number of job runs that returned an error, timed out or panicked.",
+		}, []string{"job"}),
+		skipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "scheduler",
+			Name:      "skipped_total",
+			Help:      "Number of job activations skipped because the previous run was still in progress.",
+		}, []string{"job"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "eggybyte",
+			Subsystem: "scheduler",
+			Name:      "run_duration_seconds",
+			Help:      "Duration of job runs.",
+			Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300, 900},
+		}, []string{"job"}),
+		lastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "eggybyte",
+			Subsystem: "scheduler",
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Unix time of the latest successful run of each job.",
+		}, []string{"job"}),
+	}
+}
+
+// observe records a completed run of the named job.
+func (m *schedulerMetrics) observe(name string, duration time.Duration, err error) {
+	m.runs.WithLabelValues(name).Inc()
+	m.duration.WithLabelValues(name).Observe(duration.Seconds())
+	if err != nil {
+		m.failures.WithLabelValues(name).Inc()
+		return
+	}
+	m.lastSuccess.WithLabelValues(name).Set(float64(time.Now().Unix()))
+}
+
+// Collectors returns the scheduler's Prometheus collectors: run counts,
+// failures, skipped runs, run durations and the time of the latest
+// successful run per job. Register them with a metrics registry to export
+// them; core.WithScheduler does so automatically.
+//
+// Returns:
+//   - []prometheus.Collector: Collectors to register
+//
+// Example:
+//
+//	for _, c := range sched.Collectors() {
+//	    metricsService.RegisterCollector(c)
+//	}
+func (s *Scheduler) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		s.metrics.runs,
+		s.metrics.failures,
+		s.metrics.skipped,
+		s.metrics.duration,
+		s.metrics.lastSuccess,
+	}
+}
@@ -0,0 +1,344 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule computes when a job runs next.
+type Schedule interface {
+	// Next returns the first activation time strictly after t, or the zero
+	// time if the schedule never activates again.
+	Next(t time.Time) time.Time
+}
+
+// intervalSchedule activates at a fixed interval.
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every returns a schedule that activates every interval, measured from the
+// previous activation rather than from the end of the previous run.
+// Intervals below one millisecond are raised to one millisecond.
+//
+// Parameters:
+//   - interval: Time between activations
+//
+// Returns:
+//   - Schedule: Fixed-interval schedule
+//
+// Example:
+//
+//	sched.AddJob("refresh-cache", scheduler.Every(30*time.Second), refreshCache)
+func Every(interval time.Duration) Schedule {
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	return intervalSchedule{interval: interval}
+}
+
+// Next returns t plus the interval.
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// String returns the schedule in "@every" notation.
+func (s intervalSchedule) String() string {
+	return "@every " + s.interval.String()
+}
+
+// cronSchedule activates on the minutes matched by a cron expression. Each
+// field is a bit set of the values it matches.
+type cronSchedule struct {
+	expr     string
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	location *time.Location
+
+	// domAny and dowAny record a day-of-month or day-of-week field starting
+	// with "*". As in standard cron, a day matches either day field when
+	// both are restricted.
+	domAny bool
+	dowAny bool
+}
+
+// cronField describes the range and names accepted by a cron field.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day of week accepts 7 as well as 0 for Sunday
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// descriptors maps the predefined schedules to their cron expressions.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearchYears bounds the search for the next activation of a cron
+// schedule, so expressions such as "0 0 30 2 *" do not loop forever.
+const maxSearchYears = 5
+
+// ParseCron parses a standard five-field cron expression, evaluated in the
+// local time zone.
+//
+// Parameters:
+//   - expr: Cron expression
+//
+// Returns:
+//   - Schedule: Parsed schedule
+//   - error: Returns error if the expression is invalid
+//
+// Syntax:
+//   - Fields: minute (0-59), hour (0-23), day of month (1-31),
+//     month (1-12 or JAN-DEC), day of week (0-7 or SUN-SAT, 0 and 7 are Sunday)
+//   - Each field is "*", a value, a range "a-b", a list "a,b" or a step
+//     "*/n" or "a-b/n"
+//   - Descriptors: @yearly, @annually, @monthly, @weekly, @daily,
+//     @midnight, @hourly and "@every <duration>"
+//
+// Example:
+//
+//	schedule, err := scheduler.ParseCron("*/15 9-17 * * MON-FRI")
+func ParseCron(expr string) (Schedule, error) {
+	return ParseCronInLocation(expr, time.Local)
+}
+
+// ParseCronInLocation parses a cron expression like ParseCron, evaluated in
+// the given time zone.
+//
+// Parameters:
+//   - expr: Cron expression
+//   - loc: Time zone the expression is evaluated in
+//
+// Returns:
+//   - Schedule: Parsed schedule
+//   - error: Returns error if the expression is invalid
+//
+// Example:
+//
+//	schedule, err := scheduler.ParseCronInLocation("0 3 * * *", time.UTC)
+func ParseCronInLocation(expr string, loc *time.Location) (Schedule, error) {
+	spec := strings.TrimSpace(expr)
+
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("invalid cron expression %q: interval must be positive", expr)
+		}
+		return Every(interval), nil
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		d, ok := descriptors[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("invalid cron expression %q: unknown descriptor", expr)
+		}
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	if loc == nil {
+		loc = time.Local
+	}
+	s := &cronSchedule{
+		expr:     strings.TrimSpace(expr),
+		location: loc,
+		domAny:   strings.HasPrefix(fields[2], "*"),
+		dowAny:   strings.HasPrefix(fields[4], "*"),
+	}
+
+	var err error
+	targets := []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	}
+	for i, target := range targets {
+		if *target.bits, err = parseField(fields[i], target.field); err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+	}
+
+	// Fold Sunday as 7 onto 0
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+
+	return s, nil
+}
+
+// parseField parses one comma-separated cron field into a bit set.
+func parseField(value string, field cronField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(value, ",") {
+		bits, err := parseRange(part, field)
+		if err != nil {
+			return 0, err
+		}
+		set |= bits
+	}
+	return set, nil
+}
+
+// parseRange parses a single "*", value, range or step term.
+func parseRange(term string, field cronField) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(term, "/")
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepPart)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepPart, field.name)
+		}
+		step = n
+	}
+
+	var low, high int
+	switch {
+	case rangePart == "*":
+		low, high = field.min, field.max
+	case strings.Contains(rangePart, "-"):
+		lowPart, highPart, _ := strings.Cut(rangePart, "-")
+		var err error
+		if low, err = parseValue(lowPart, field); err != nil {
+			return 0, err
+		}
+		if high, err = parseValue(highPart, field); err != nil {
+			return 0, err
+		}
+		if low > high {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+		}
+	default:
+		value, err := parseValue(rangePart, field)
+		if err != nil {
+			return 0, err
+		}
+		// "5/10" means starting at 5 every 10, as in most cron implementations
+		low, high = value, value
+		if hasStep {
+			high = field.max
+		}
+	}
+
+	var set uint64
+	for v := low; v <= high; v += step {
+		set |= 1 << uint(v)
+	}
+	return set, nil
+}
+
+// parseValue parses a number or name within the field's range.
+func parseValue(value string, field cronField) (int, error) {
+	if n, ok := field.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] in %s field", n, field.min, field.max, field.name)
+	}
+	return n, nil
+}
+
+// Next returns the first minute after t matched by the expression, or the
+// zero time if none exists within five years.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	origLoc := t.Location()
+	t = t.In(s.location)
+
+	// Start at the beginning of the next whole minute
+	t = t.Truncate(time.Second).Add(time.Duration(60-t.Second()) * time.Second)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = advance(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location))
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = advance(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location))
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t.In(origLoc)
+	}
+	return time.Time{}
+}
+
+// advance returns next, or t plus an hour if a daylight saving transition
+// made next fall at or before t.
+func advance(t, next time.Time) time.Time {
+	if !next.After(t) {
+		return t.Add(time.Hour)
+	}
+	return next
+}
+
+// dayMatches reports whether t's day matches the day-of-month and
+// day-of-week fields.
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dowMatch
+	case s.dowAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// String returns the expression the schedule was parsed from.
+func (s *cronSchedule) String() string {
+	return s.expr
+}
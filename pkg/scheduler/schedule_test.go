@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCron_Next tests the activation times of cron expressions.
+// This verifies ranges, lists, steps, names and descriptors select the expected minutes.
+func TestParseCron_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2024, time.January, 10, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 10, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 10, 10, 30, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2024, 1, 10, 10, 25, 0, 0, time.UTC)},
+		{"0,45 * * * *", time.Date(2024, 1, 10, 10, 45, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2024, 1, 10, 13, 0, 0, 0, time.UTC)},
+		{"30 8 * * *", time.Date(2024, 1, 11, 8, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * MON", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * sat,SUN", time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 FEB *", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 10, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 90s", from.Add(90 * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := ParseCronInLocation(tt.expr, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
+
+// TestParseCron_DayFields tests combining day of month and day of week.
+// This verifies a day matches either field when both are restricted, as in standard cron.
+func TestParseCron_DayFields(t *testing.T) {
+	schedule, err := ParseCronInLocation("0 0 13 * FRI", time.UTC)
+	require.NoError(t, err)
+
+	// Wednesday 10 January: the next Friday (12th) comes before the 13th
+	next := schedule.Next(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC), next)
+
+	next = schedule.Next(next)
+	assert.Equal(t, time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC), next)
+}
+
+// TestParseCron_Invalid tests rejecting malformed expressions.
+// This verifies the field count, values, ranges, steps and descriptors are validated.
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"10-5 * * * *",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"a * * * *",
+		"* * * FOO *",
+		"@fortnightly",
+		"@every soon",
+		"@every -1m",
+	} {
+		_, err := ParseCron(expr)
+		assert.Error(t, err, "expression %q", expr)
+	}
+}
+
+// TestParseCron_NoActivation tests an expression that never matches.
+// This verifies Next gives up and returns the zero time.
+func TestParseCron_NoActivation(t *testing.T) {
+	schedule, err := ParseCronInLocation("0 0 30 2 *", time.UTC)
+	require.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
+
+// TestParseCron_Location tests evaluating an expression in a time zone.
+// This verifies the hour field follows the zone, including across daylight saving transitions.
+func TestParseCron_Location(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	schedule, err := ParseCronInLocation("30 2 * * *", loc)
+	require.NoError(t, err)
+
+	// 02:30 does not exist on 10 March 2024, so the job runs on the 11th
+	next := schedule.Next(time.Date(2024, 3, 9, 12, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2024, 3, 11, 2, 30, 0, 0, loc), next)
+
+	schedule, err = ParseCronInLocation("0 9 * * *", loc)
+	require.NoError(t, err)
+	next = schedule.Next(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC), next.UTC())
+	assert.Equal(t, time.UTC, next.Location(), "Next returns times in the caller's location")
+}
+
+// TestEvery tests fixed-interval schedules.
+// This verifies activations are spaced by the interval and tiny intervals are raised.
+func TestEvery(t *testing.T) {
+	from := time.Now()
+	assert.Equal(t, from.Add(time.Minute), Every(time.Minute).Next(from))
+	assert.Equal(t, from.Add(time.Millisecond), Every(0).Next(from))
+}
@@ -0,0 +1,424 @@
+// Package scheduler provides a periodic job scheduler for EggyByte
+// services. Jobs run on cron expressions or fixed intervals, with optional
+// jitter and per-run timeouts, and a job is never run concurrently with
+// itself unless allowed.
+//
+// Example Usage:
+//
+//	sched := scheduler.New()
+//	if err := sched.AddCronJob("purge-sessions", "*/10 * * * *", purgeSessions,
+//	    scheduler.WithTimeout(time.Minute)); err != nil {
+//	    log.Fatal("Failed to add job", log.Field{Key: "error", Value: err})
+//	}
+//	core.BootstrapWithOptions(ctx, cfg, core.WithScheduler(sched))
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// Job is the work run on each activation of a schedule. The context is
+// canceled when the run times out or the scheduler is stopped, and carries
+// a logger and request ID identifying the run (see log.FromContext).
+type Job func(ctx context.Context) error
+
+// JobOption customizes a job registered with AddJob.
+type JobOption func(*job)
+
+// WithJitter delays each run by a random duration in [0, jitter), so
+// replicas sharing a schedule do not all run at the same instant.
+//
+// Parameters:
+//   - jitter: Upper bound of the random delay
+//
+// Returns:
+//   - JobOption: Option for AddJob
+//
+// Example:
+//
+//	sched.AddJob("report", scheduler.Every(time.Hour), sendReport, scheduler.WithJitter(time.Minute))
+func WithJitter(jitter time.Duration) JobOption {
+	return func(j *job) {
+		j.jitter = jitter
+	}
+}
+
+// WithTimeout bounds each run of the job. The run's context is canceled
+// once timeout has elapsed and the run counts as failed if it returns an
+// error. Jobs registered without it run until they return or the scheduler
+// is stopped.
+//
+// Parameters:
+//   - timeout: Maximum duration of a run
+//
+// Returns:
+//   - JobOption: Option for AddJob
+//
+// Example:
+//
+//	sched.AddJob("sync", scheduler.Every(5*time.Minute), syncAccounts, scheduler.WithTimeout(time.Minute))
+func WithTimeout(timeout time.Duration) JobOption {
+	return func(j *job) {
+		j.timeout = timeout
+	}
+}
+
+// WithOverlap allows a run to start while the previous run of the same job
+// is still in progress. By default such activations are skipped.
+//
+// Returns:
+//   - JobOption: Option for AddJob
+func WithOverlap() JobOption {
+	return func(j *job) {
+		j.allowOverlap = true
+	}
+}
+
+// job is a scheduled job and its settings.
+type job struct {
+	name         string
+	schedule     Schedule
+	fn           Job
+	jitter       time.Duration
+	timeout      time.Duration
+	allowOverlap bool
+
+	// running counts the runs in progress
+	running atomic.Int32
+}
+
+// Scheduler runs jobs on their schedules. It implements service.Service,
+// so it is registered with a launcher like any other service; jobs run
+// only while Start is running.
+//
+// Each run gets a context logger from log.WithLogger carrying the job name
+// and a fresh request ID. Run counts, durations, failures and skipped runs
+// are exported through Collectors.
+//
+// Thread Safety: All methods are safe for concurrent use. Jobs may be added
+// while the scheduler runs.
+type Scheduler struct {
+	logger  log.Logger
+	metrics *schedulerMetrics
+
+	// mu protects the fields below
+	mu sync.Mutex
+
+	// jobs holds the registered jobs in registration order
+	jobs []*job
+
+	// run is the current run; nil while Start is not running
+	run *schedulerRun
+}
+
+// schedulerRun is one call to Start.
+type schedulerRun struct {
+	// ctx is canceled to stop scheduling new runs
+	ctx  context.Context
+	stop context.CancelFunc
+
+	// runCtx is the parent of every job run; it is canceled when Start's
+	// context is canceled or Stop's deadline passes
+	runCtx     context.Context
+	cancelRuns context.CancelFunc
+
+	// loops tracks the scheduling goroutines and runs the job runs
+	loops sync.WaitGroup
+	runs  sync.WaitGroup
+
+	// done is closed when Start returns
+	done chan struct{}
+}
+
+// New creates a scheduler with no jobs.
+//
+// Returns:
+//   - *Scheduler: Scheduler ready for AddJob
+//
+// Example:
+//
+//	sched := scheduler.New()
+//	sched.AddJob("heartbeat", scheduler.Every(10*time.Second), sendHeartbeat)
+func New() *Scheduler {
+	return &Scheduler{
+		logger:  log.Default(),
+		metrics: newSchedulerMetrics(),
+	}
+}
+
+// AddJob registers a job to run on schedule.
+//
+// Parameters:
+//   - name: Unique job name, used in logs and metrics
+//   - schedule: When the job runs, e.g. from Every or ParseCron
+//   - fn: Work to run on each activation
+//   - opts: Options such as WithJitter, WithTimeout and WithOverlap
+//
+// Returns:
+//   - error: Returns error if the name is empty or taken, or the schedule
+//     or function is nil
+//
+// Example:
+//
+//	err := sched.AddJob("refresh-rates", scheduler.Every(time.Minute), refreshRates,
+//	    scheduler.WithJitter(5*time.Second))
+func (s *Scheduler) AddJob(name string, schedule Schedule, fn Job, opts ...JobOption) error {
+	if name == "" {
+		return fmt.Errorf("job name must not be empty")
+	}
+	if schedule == nil {
+		return fmt.Errorf("job %s has no schedule", name)
+	}
+	if fn == nil {
+		return fmt.Errorf("job %s has no function", name)
+	}
+
+	j := &job{name: name, schedule: schedule, fn: fn}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(j)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.jobs {
+		if existing.name == name {
+			return fmt.Errorf("job %s already registered", name)
+		}
+	}
+	s.jobs = append(s.jobs, j)
+
+	// Schedule the job right away if the scheduler is running
+	if run := s.run; run != nil && run.ctx.Err() == nil {
+		run.loops.Add(1)
+		go s.loop(run, j)
+	}
+	return nil
+}
+
+// AddCronJob registers a job to run on a cron expression evaluated in the
+// local time zone. See ParseCron for the syntax.
+//
+// Parameters:
+//   - name: Unique job name, used in logs and metrics
+//   - expr: Cron expression
+//   - fn: Work to run on each activation
+//   - opts: Options such as WithJitter, WithTimeout and WithOverlap
+//
+// Returns:
+//   - error: Returns error if the expression is invalid or AddJob fails
+//
+// Example:
+//
+//	err := sched.AddCronJob("nightly-report", "0 2 * * *", buildReport)
+func (s *Scheduler) AddCronJob(name, expr string, fn Job, opts ...JobOption) error {
+	schedule, err := ParseCron(expr)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", name, err)
+	}
+	return s.AddJob(name, schedule, fn, opts...)
+}
+
+// Start runs the registered jobs on their schedules until ctx is canceled
+// or Stop is called, then waits for the runs in progress to return.
+//
+// Parameters:
+//   - ctx: Context whose cancellation stops the scheduler and cancels the
+//     runs in progress
+//
+// Returns:
+//   - error: Returns error if the scheduler is already running
+func (s *Scheduler) Start(ctx context.Context) error {
+	loopCtx, stop := context.WithCancel(ctx)
+	runCtx, cancelRuns := context.WithCancel(context.WithoutCancel(ctx))
+	run := &schedulerRun{
+		ctx:        loopCtx,
+		stop:       stop,
+		runCtx:     runCtx,
+		cancelRuns: cancelRuns,
+		done:       make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	if s.run != nil {
+		s.mu.Unlock()
+		stop()
+		cancelRuns()
+		return fmt.Errorf("scheduler already started")
+	}
+	s.run = run
+	for _, j := range s.jobs {
+		run.loops.Add(1)
+		go s.loop(run, j)
+	}
+	jobCount := len(s.jobs)
+	s.mu.Unlock()
+
+	s.logger.Info("Scheduler started", log.Field{Key: "jobs", Value: jobCount})
+
+	select {
+	case <-ctx.Done():
+		cancelRuns()
+	case <-loopCtx.Done():
+	}
+
+	// Stop under the lock so AddJob cannot start a loop after the wait begins
+	s.mu.Lock()
+	stop()
+	s.mu.Unlock()
+
+	run.loops.Wait()
+	run.runs.Wait()
+	cancelRuns()
+
+	s.mu.Lock()
+	s.run = nil
+	s.mu.Unlock()
+	close(run.done)
+
+	s.logger.Info("Scheduler stopped")
+	return nil
+}
+
+// Stop stops scheduling new runs and waits for the runs in progress to
+// return. If ctx expires first, the runs are canceled and Stop returns
+// without waiting further.
+//
+// Parameters:
+//   - ctx: Context bounding the wait for runs in progress
+//
+// Returns:
+//   - error: Returns error if ctx expires before the runs return
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	run := s.run
+	s.mu.Unlock()
+	if run == nil {
+		return nil
+	}
+
+	run.stop()
+	select {
+	case <-run.done:
+		return nil
+	case <-ctx.Done():
+		run.cancelRuns()
+		return fmt.Errorf("scheduler did not stop in time: %w", ctx.Err())
+	}
+}
+
+// SetLogger sets the logger for scheduler events. Job runs log through the
+// context logger created by log.WithLogger.
+//
+// Parameters:
+//   - logger: The logger instance to use for this scheduler
+func (s *Scheduler) SetLogger(logger interface{}) {
+	if l := log.SetLoggerHelper(logger); l != nil {
+		s.logger = l
+	}
+}
+
+// loop runs j on its schedule until run is stopped.
+func (s *Scheduler) loop(run *schedulerRun, j *job) {
+	defer run.loops.Done()
+
+	next := j.schedule.Next(time.Now())
+	for {
+		if next.IsZero() {
+			s.logger.Warn("Job schedule has no further activations",
+				log.Field{Key: "job", Value: j.name})
+			return
+		}
+
+		delay := time.Until(next)
+		if j.jitter > 0 {
+			delay += rand.N(j.jitter)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-run.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.dispatch(run, j)
+
+		// Skip activations missed while the process was paused
+		now := time.Now()
+		next = j.schedule.Next(next)
+		if !next.IsZero() && next.Before(now) {
+			next = j.schedule.Next(now)
+		}
+	}
+}
+
+// dispatch starts a run of j unless the previous run is still in progress
+// and overlapping is not allowed.
+func (s *Scheduler) dispatch(run *schedulerRun, j *job) {
+	if !j.allowOverlap && !j.running.CompareAndSwap(0, 1) {
+		s.metrics.skipped.WithLabelValues(j.name).Inc()
+		s.logger.Warn("Skipping job run, previous run still in progress",
+			log.Field{Key: "job", Value: j.name})
+		return
+	}
+	if j.allowOverlap {
+		j.running.Add(1)
+	}
+
+	run.runs.Add(1)
+	go func() {
+		defer run.runs.Done()
+		defer j.running.Add(-1)
+		s.execute(run.runCtx, j)
+	}()
+}
+
+// execute runs j once and records the outcome.
+func (s *Scheduler) execute(ctx context.Context, j *job) {
+	if j.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, j.timeout)
+		defer cancel()
+	}
+	ctx, logger := log.WithLogger(ctx, "", log.Field{Key: "job", Value: j.name})
+
+	logger.Debug("Job run started")
+	start := time.Now()
+	err := j.call(ctx)
+	duration := time.Since(start)
+
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("job %s timed out after %s: %w", j.name, j.timeout, err)
+	}
+	s.metrics.observe(j.name, duration, err)
+
+	if err != nil {
+		logger.Error("Job run failed",
+			log.Field{Key: "duration", Value: duration},
+			log.Field{Key: "error", Value: err})
+		return
+	}
+	logger.Debug("Job run completed", log.Field{Key: "duration", Value: duration})
+}
+
+// call runs the job function, turning a panic into an error.
+func (j *job) call(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %s panicked: %v", j.name, r)
+		}
+	}()
+	return j.fn(ctx)
+}
@@ -0,0 +1,252 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// runScheduler starts s in the background and returns a function that
+// stops it and reports Start's result.
+func runScheduler(t *testing.T, s *Scheduler) func() error {
+	t.Helper()
+	done := make(chan error, 1)
+	go func() { done <- s.Start(context.Background()) }()
+
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if err := s.Stop(ctx); err != nil {
+			return err
+		}
+		return <-done
+	}
+}
+
+// TestScheduler_RunsIntervalJob tests a fixed-interval job.
+// This verifies the job runs repeatedly with a context logger and its runs are counted.
+func TestScheduler_RunsIntervalJob(t *testing.T) {
+	log.Init("info", "json")
+	s := New()
+
+	var runs atomic.Int32
+	var requestIDs sync.Map
+	require.NoError(t, s.AddJob("tick", Every(10*time.Millisecond), func(ctx context.Context) error {
+		requestIDs.Store(log.GetRequestID(ctx), true)
+		assert.NotNil(t, log.FromContext(ctx))
+		runs.Add(1)
+		return nil
+	}))
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, 2*time.Second, 5*time.Millisecond)
+	require.NoError(t, stop())
+
+	distinct := 0
+	requestIDs.Range(func(key, _ any) bool {
+		assert.NotEmpty(t, key)
+		distinct++
+		return true
+	})
+	assert.Equal(t, int(runs.Load()), distinct, "Each run gets its own request ID")
+
+	assert.Equal(t, float64(runs.Load()), testutil.ToFloat64(s.metrics.runs.WithLabelValues("tick")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(s.metrics.failures.WithLabelValues("tick")))
+	assert.Greater(t, testutil.ToFloat64(s.metrics.lastSuccess.WithLabelValues("tick")), float64(0))
+	assert.Len(t, s.Collectors(), 5)
+}
+
+// TestScheduler_Failures tests jobs that fail, panic or time out.
+// This verifies each outcome counts as a failure and the scheduler keeps running.
+func TestScheduler_Failures(t *testing.T) {
+	log.Init("info", "json")
+	s := New()
+
+	require.NoError(t, s.AddJob("fails", Every(10*time.Millisecond), func(ctx context.Context) error {
+		return errors.New("upstream unavailable")
+	}))
+	require.NoError(t, s.AddJob("panics", Every(10*time.Millisecond), func(ctx context.Context) error {
+		panic("nil map")
+	}))
+	var deadlines atomic.Int32
+	require.NoError(t, s.AddJob("slow", Every(10*time.Millisecond), func(ctx context.Context) error {
+		<-ctx.Done()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			deadlines.Add(1)
+		}
+		return ctx.Err()
+	}, WithTimeout(20*time.Millisecond)))
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(s.metrics.failures.WithLabelValues("fails")) >= 2 &&
+			testutil.ToFloat64(s.metrics.failures.WithLabelValues("panics")) >= 2 &&
+			testutil.ToFloat64(s.metrics.failures.WithLabelValues("slow")) >= 2
+	}, 2*time.Second, 5*time.Millisecond)
+	require.NoError(t, stop())
+
+	assert.GreaterOrEqual(t, deadlines.Load(), int32(2))
+	assert.Equal(t, float64(0), testutil.ToFloat64(s.metrics.lastSuccess.WithLabelValues("fails")))
+}
+
+// TestScheduler_PreventsOverlap tests a job that runs longer than its interval.
+// This verifies activations during a run are skipped unless WithOverlap is given.
+func TestScheduler_PreventsOverlap(t *testing.T) {
+	log.Init("info", "json")
+	s := New()
+
+	var active, maxActive, overlapActive, overlapMax atomic.Int32
+	track := func(active, max *atomic.Int32) Job {
+		return func(ctx context.Context) error {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				current := max.Load()
+				if n <= current || max.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		}
+	}
+	require.NoError(t, s.AddJob("exclusive", Every(10*time.Millisecond), track(&active, &maxActive)))
+	require.NoError(t, s.AddJob("overlapping", Every(10*time.Millisecond), track(&overlapActive, &overlapMax), WithOverlap()))
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(s.metrics.skipped.WithLabelValues("exclusive")) >= 3 && overlapMax.Load() >= 2
+	}, 2*time.Second, 5*time.Millisecond)
+	require.NoError(t, stop())
+
+	assert.Equal(t, int32(1), maxActive.Load())
+	assert.Equal(t, float64(0), testutil.ToFloat64(s.metrics.skipped.WithLabelValues("overlapping")))
+}
+
+// TestScheduler_StopWaitsForRuns tests stopping during a run.
+// This verifies Stop lets the run finish, and cancels it once Stop's deadline passes.
+func TestScheduler_StopWaitsForRuns(t *testing.T) {
+	log.Init("info", "json")
+
+	t.Run("finishes", func(t *testing.T) {
+		s := New()
+		started := make(chan struct{}, 1)
+		var finished atomic.Bool
+		require.NoError(t, s.AddJob("report", Every(10*time.Millisecond), func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			time.Sleep(100 * time.Millisecond)
+			finished.Store(ctx.Err() == nil)
+			return nil
+		}))
+
+		stop := runScheduler(t, s)
+		<-started
+		require.NoError(t, stop())
+		assert.True(t, finished.Load(), "The run in progress completes before Stop returns")
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		s := New()
+		started := make(chan struct{}, 1)
+		canceled := make(chan struct{})
+		require.NoError(t, s.AddJob("stuck", Every(10*time.Millisecond), func(ctx context.Context) error {
+			started <- struct{}{}
+			<-ctx.Done()
+			close(canceled)
+			return ctx.Err()
+		}))
+
+		done := make(chan error, 1)
+		go func() { done <- s.Start(context.Background()) }()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := s.Stop(ctx)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		<-canceled
+		require.NoError(t, <-done)
+	})
+}
+
+// TestScheduler_ContextCancel tests canceling Start's context.
+// This verifies Start returns and cancels the runs in progress.
+func TestScheduler_ContextCancel(t *testing.T) {
+	log.Init("info", "json")
+	s := New()
+
+	started := make(chan struct{}, 1)
+	require.NoError(t, s.AddJob("stuck", Every(10*time.Millisecond), func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Start(ctx) }()
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Start did not return after its context was canceled")
+	}
+	assert.NoError(t, s.Stop(context.Background()), "Stop after Start returned is a no-op")
+}
+
+// TestScheduler_AddJob tests registering jobs.
+// This verifies invalid jobs are rejected and jobs added while running are scheduled.
+func TestScheduler_AddJob(t *testing.T) {
+	log.Init("info", "json")
+	s := New()
+	noop := func(ctx context.Context) error { return nil }
+
+	assert.Error(t, s.AddJob("", Every(time.Second), noop))
+	assert.Error(t, s.AddJob("job", nil, noop))
+	assert.Error(t, s.AddJob("job", Every(time.Second), nil))
+	assert.Error(t, s.AddCronJob("job", "not a cron", noop))
+
+	require.NoError(t, s.AddCronJob("nightly", "0 2 * * *", noop, WithJitter(time.Minute)))
+	err := s.AddJob("nightly", Every(time.Second), noop)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.run != nil
+	}, time.Second, 5*time.Millisecond)
+	assert.Error(t, s.Start(context.Background()), "A second Start fails while running")
+
+	ran := make(chan struct{})
+	var once sync.Once
+	require.NoError(t, s.AddJob("late", Every(10*time.Millisecond), func(ctx context.Context) error {
+		once.Do(func() { close(ran) })
+		return nil
+	}, WithJitter(5*time.Millisecond)))
+
+	select {
+	case <-ran:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Job added while running was not scheduled")
+	}
+	require.NoError(t, stop())
+}
@@ -70,6 +70,12 @@ type GRPCServer struct {
 
 	// mu protects concurrent access to enableReflection and listener fields
 	mu sync.RWMutex
+
+	// ready is closed once the server is listening
+	ready chan struct{}
+
+	// readyOnce guards closing ready
+	readyOnce sync.Once
 }
 
 // NewGRPCServer creates a new business gRPC server with the specified port.
@@ -94,6 +100,7 @@ func NewGRPCServer(port string) *GRPCServer {
 		port:             port,
 		logger:           log.Default(),
 		enableReflection: false, // Disabled by default for security
+		ready:            make(chan struct{}),
 	}
 }
 
@@ -121,6 +128,7 @@ func NewGRPCServerWithOptions(port string, options ...grpc.ServerOption) *GRPCSe
 		port:             port,
 		logger:           log.Default(),
 		enableReflection: false,
+		ready:            make(chan struct{}),
 	}
 }
 
@@ -160,6 +168,7 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 	s.mu.Lock()
 	s.listener = listener
 	s.mu.Unlock()
+	s.readyOnce.Do(func() { close(s.ready) })
 
 	// Enable reflection if configured
 	s.mu.RLock()
@@ -216,6 +225,15 @@ func (s *GRPCServer) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Ready returns a channel that is closed once the server is listening.
+// It implements service.ReadyNotifier.
+//
+// Returns:
+//   - <-chan struct{}: Channel closed when the server accepts connections
+func (s *GRPCServer) Ready() <-chan struct{} {
+	return s.ready
+}
+
 // GetPort returns the configured port for this server.
 // This method is useful for logging and monitoring purposes.
 //
@@ -225,6 +243,26 @@ func (s *GRPCServer) GetPort() string {
 	return s.port
 }
 
+// Addr returns the address the server is listening on. Unlike GetPort it
+// reports the port actually bound, which differs when the configured
+// port is 0.
+//
+// Returns:
+//   - net.Addr: The bound address, or nil before the server is listening
+//
+// Example:
+//
+//	<-server.Ready()
+//	port := server.Addr().(*net.TCPAddr).Port
+func (s *GRPCServer) Addr() net.Addr {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
 // GetServer returns the underlying grpc.Server instance.
 // This method is provided for service registration and advanced configuration.
 //
@@ -314,3 +314,47 @@ func TestGRPCServer_ServerOptions(t *testing.T) {
 	grpcServer := server.GetServer()
 	assert.NotNil(t, grpcServer)
 }
+
+func TestGRPCServer_Ready(t *testing.T) {
+	server := NewGRPCServer("127.0.0.1:0")
+
+	select {
+	case <-server.Ready():
+		t.Fatal("Server must not be ready before Start")
+	default:
+	}
+	assert.Nil(t, server.Addr(), "No address before Start")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.Start(ctx)
+	}()
+
+	select {
+	case <-server.Ready():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Server did not report ready")
+	}
+	addr, ok := server.Addr().(*net.TCPAddr)
+	require.True(t, ok, "Addr reports the bound TCP address once ready")
+	assert.NotZero(t, addr.Port, "Port 0 resolves to the bound port")
+
+	cancel()
+	assert.NoError(t, <-errChan)
+}
+
+func TestGRPCServer_NotReadyOnBindFailure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	server := NewGRPCServer(listener.Addr().String())
+
+	assert.Error(t, server.Start(context.Background()))
+	select {
+	case <-server.Ready():
+		t.Fatal("Server must not be ready when binding fails")
+	default:
+	}
+}
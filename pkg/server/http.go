@@ -6,7 +6,10 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
@@ -22,6 +25,7 @@ import (
 // Usage:
 //
 //	server := NewHTTPServer(":8080")
+//	server.Use(RequestID(), Recovery(), AccessLog())
 //	server.HandleFunc("/api/v1/users", userHandler)
 //	go server.Start(ctx)
 type HTTPServer struct {
@@ -34,8 +38,26 @@ type HTTPServer struct {
 	// mux is the HTTP request multiplexer
 	mux *http.ServeMux
 
+	// mu guards middleware and listener
+	mu sync.Mutex
+
+	// listener is the bound listener, nil until Start binds it
+	listener net.Listener
+
+	// middleware is the global chain added with Use, outermost first
+	middleware []Middleware
+
+	// handler is mux wrapped in the global chain; it serves every request
+	handler atomic.Pointer[http.Handler]
+
 	// logger is the structured logger for this server
 	logger log.Logger
+
+	// ready is closed once the server is listening
+	ready chan struct{}
+
+	// readyOnce guards closing ready
+	readyOnce sync.Once
 }
 
 // NewHTTPServer creates a new business HTTP server with the specified port.
@@ -54,10 +76,9 @@ type HTTPServer struct {
 func NewHTTPServer(port string) *HTTPServer {
 	mux := http.NewServeMux()
 
-	return &HTTPServer{
+	s := &HTTPServer{
 		server: &http.Server{
 			Addr:         port,
-			Handler:      mux,
 			ReadTimeout:  30 * time.Second,
 			WriteTimeout: 30 * time.Second,
 			IdleTimeout:  120 * time.Second,
@@ -65,7 +86,43 @@ func NewHTTPServer(port string) *HTTPServer {
 		port:   port,
 		mux:    mux,
 		logger: log.Default(),
+		ready:  make(chan struct{}),
 	}
+	var handler http.Handler = mux
+	s.handler.Store(&handler)
+	s.server.Handler = s
+	return s
+}
+
+// Use appends middleware to the global chain, which wraps every request
+// served, including requests that match no route. Middleware run in the
+// order added: the first is the outermost. Routes registered before or
+// after Use are both affected.
+//
+// Parameters:
+//   - middleware: Middleware to add, outermost first
+//
+// Example:
+//
+//	server.Use(RequestID(), Recovery(), AccessLog())
+func (s *HTTPServer) Use(middleware ...Middleware) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.middleware = append(s.middleware, middleware...)
+	handler := Chain(s.middleware...)(s.mux)
+	s.handler.Store(&handler)
+}
+
+// ServeHTTP serves a request through the global middleware chain and the
+// registered routes. It lets the server be used directly as an
+// http.Handler, e.g. with httptest.
+//
+// Parameters:
+//   - w: Response writer
+//   - r: Incoming request
+func (s *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*s.handler.Load()).ServeHTTP(w, r)
 }
 
 // HandleFunc registers a handler function for the given pattern.
@@ -74,6 +131,8 @@ func NewHTTPServer(port string) *HTTPServer {
 // Parameters:
 //   - pattern: The URL pattern to match (e.g., "/api/v1/users")
 //   - handler: The handler function to execute for matching requests
+//   - middleware: Optional per-route middleware, outermost first; it runs
+//     inside the global chain added with Use
 //
 // Example:
 //
@@ -81,8 +140,8 @@ func NewHTTPServer(port string) *HTTPServer {
 //	    w.WriteHeader(http.StatusOK)
 //	    w.Write([]byte("Hello, World!"))
 //	})
-func (s *HTTPServer) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
-	s.mux.HandleFunc(pattern, handler)
+func (s *HTTPServer) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request), middleware ...Middleware) {
+	s.mux.Handle(pattern, Chain(middleware...)(http.HandlerFunc(handler)))
 	s.logger.Info("HTTP route registered",
 		log.Field{Key: "pattern", Value: pattern},
 		log.Field{Key: "port", Value: s.port})
@@ -94,12 +153,15 @@ func (s *HTTPServer) HandleFunc(pattern string, handler func(http.ResponseWriter
 // Parameters:
 //   - pattern: The URL pattern to match (e.g., "/api/v1/users")
 //   - handler: The handler to execute for matching requests
+//   - middleware: Optional per-route middleware, outermost first; it runs
+//     inside the global chain added with Use
 //
 // Example:
 //
 //	server.Handle("/api/v1/users", http.HandlerFunc(userHandler))
-func (s *HTTPServer) Handle(pattern string, handler http.Handler) {
-	s.mux.Handle(pattern, handler)
+//	server.Handle("/api/v1/reports", reportHandler, Timeout(10*time.Second))
+func (s *HTTPServer) Handle(pattern string, handler http.Handler, middleware ...Middleware) {
+	s.mux.Handle(pattern, Chain(middleware...)(handler))
 	s.logger.Info("HTTP route registered",
 		log.Field{Key: "pattern", Value: pattern},
 		log.Field{Key: "port", Value: s.port})
@@ -134,12 +196,22 @@ func (s *HTTPServer) Start(ctx context.Context) error {
 		log.Field{Key: "port", Value: s.port},
 		log.Field{Key: "address", Value: s.server.Addr})
 
+	// Bind before serving so readiness reflects an open port
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("HTTP server failed: %w", err)
+	}
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+	s.readyOnce.Do(func() { close(s.ready) })
+
 	// Create a channel to receive server errors
 	errChan := make(chan error, 1)
 
 	// Start server in a goroutine
 	go func() {
-		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
 			errChan <- fmt.Errorf("HTTP server failed: %w", err)
 		}
 	}()
@@ -183,6 +255,15 @@ func (s *HTTPServer) Stop(ctx context.Context) error {
 	return s.server.Shutdown(ctx)
 }
 
+// Ready returns a channel that is closed once the server is listening.
+// It implements service.ReadyNotifier.
+//
+// Returns:
+//   - <-chan struct{}: Channel closed when the server accepts connections
+func (s *HTTPServer) Ready() <-chan struct{} {
+	return s.ready
+}
+
 // GetPort returns the configured port for this server.
 // This method is useful for logging and monitoring purposes.
 //
@@ -192,6 +273,26 @@ func (s *HTTPServer) GetPort() string {
 	return s.port
 }
 
+// Addr returns the address the server is listening on. Unlike GetPort it
+// reports the port actually bound, which differs when the configured
+// port is 0.
+//
+// Returns:
+//   - net.Addr: The bound address, or nil before the server is listening
+//
+// Example:
+//
+//	<-server.Ready()
+//	port := server.Addr().(*net.TCPAddr).Port
+func (s *HTTPServer) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
 // GetServer returns the underlying http.Server instance.
 // This method is provided for advanced use cases where direct access
 // to the http.Server is needed for custom configuration.
@@ -3,12 +3,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHTTPServer(t *testing.T) {
@@ -311,3 +313,32 @@ func TestHTTPServer_MethodNotAllowed(t *testing.T) {
 
 	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
 }
+
+func TestHTTPServer_Ready(t *testing.T) {
+	server := NewHTTPServer("127.0.0.1:0")
+
+	select {
+	case <-server.Ready():
+		t.Fatal("Server must not be ready before Start")
+	default:
+	}
+	assert.Nil(t, server.Addr(), "No address before Start")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.Start(ctx)
+	}()
+
+	select {
+	case <-server.Ready():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Server did not report ready")
+	}
+	addr, ok := server.Addr().(*net.TCPAddr)
+	require.True(t, ok, "Addr reports the bound TCP address once ready")
+	assert.NotZero(t, addr.Port, "Port 0 resolves to the bound port")
+
+	cancel()
+	assert.NoError(t, <-errChan)
+}
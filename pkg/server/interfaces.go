@@ -22,11 +22,16 @@ type HTTPServerInterface interface {
 	// Stop gracefully shuts down the HTTP server.
 	Stop(ctx context.Context) error
 
-	// HandleFunc registers a handler function for the given pattern.
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	// HandleFunc registers a handler function for the given pattern,
+	// wrapped in optional per-route middleware.
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request), middleware ...Middleware)
 
-	// Handle registers a handler for the given pattern.
-	Handle(pattern string, handler http.Handler)
+	// Handle registers a handler for the given pattern, wrapped in
+	// optional per-route middleware.
+	Handle(pattern string, handler http.Handler, middleware ...Middleware)
+
+	// Use appends middleware to the chain wrapping every request.
+	Use(middleware ...Middleware)
 
 	// GetPort returns the configured port for this server.
 	GetPort() string
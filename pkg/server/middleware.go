@@ -0,0 +1,261 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// Middleware wraps an http.Handler with cross-cutting behavior such as
+// logging, recovery or authentication. It is an alias, so plain
+// func(http.Handler) http.Handler values can be used directly.
+type Middleware = func(http.Handler) http.Handler
+
+// Chain composes middleware into one. The first middleware is the
+// outermost: Chain(a, b)(h) serves requests through a, then b, then h.
+// Nil entries are skipped.
+//
+// Parameters:
+//   - middleware: Middleware to compose, outermost first
+//
+// Returns:
+//   - Middleware: Composed middleware
+//
+// Example:
+//
+//	api := server.Chain(authenticate, server.Timeout(5*time.Second))
+//	httpServer.Handle("/api/v1/orders", api(ordersHandler))
+func Chain(middleware ...Middleware) Middleware {
+	return func(next http.Handler) http.Handler {
+		for i := len(middleware) - 1; i >= 0; i-- {
+			if middleware[i] != nil {
+				next = middleware[i](next)
+			}
+		}
+		return next
+	}
+}
+
+// Recovery returns middleware that recovers from panics in later handlers.
+// The panic and stack trace are logged with the request's context logger,
+// and a 500 response is sent if the handler has not written one yet.
+// http.ErrAbortHandler is re-panicked so net/http can abort the response.
+//
+// Place RequestID before Recovery so the panic is logged with the request
+// ID. When Recovery runs first, it falls back to the ID RequestID echoed in
+// the X-Request-ID response header, then to the request's header.
+//
+// Returns:
+//   - Middleware: Panic recovery middleware
+//
+// Example:
+//
+//	httpServer.Use(server.RequestID(), server.Recovery())
+func Recovery() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := newStatusRecorder(w)
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+
+				logger := log.FromContext(r.Context())
+				if log.GetRequestID(r.Context()) == "" {
+					if id := recoveredRequestID(w, r); id != "" {
+						logger = logger.With(log.Field{Key: "request_id", Value: id})
+					}
+				}
+				logger.Error("HTTP handler panicked",
+					log.Field{Key: "method", Value: r.Method},
+					log.Field{Key: "path", Value: r.URL.Path},
+					log.Field{Key: "panic", Value: fmt.Sprint(p)},
+					log.Field{Key: "stack", Value: string(debug.Stack())})
+
+				if !rec.wroteHeader {
+					http.Error(rec, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(rec, r)
+		})
+	}
+}
+
+// recoveredRequestID returns the request ID of a request whose context
+// carries none because RequestID runs after Recovery: the ID RequestID set
+// on the response, else a valid X-Request-ID request header, else "".
+func recoveredRequestID(w http.ResponseWriter, r *http.Request) string {
+	if id := w.Header().Get(RequestIDHeader); validRequestID(id) {
+		return id
+	}
+	if id := r.Header.Get(RequestIDHeader); validRequestID(id) {
+		return id
+	}
+	return ""
+}
+
+// RequestID returns middleware that gives each request an ID and a
+// request-scoped logger created with log.WithLogger. The ID is taken from
+// the request context if an earlier middleware set one, then from the
+// X-Request-ID request header when it holds a valid ID, and generated
+// otherwise. It is echoed in the X-Request-ID response header. Later
+// handlers read it with log.GetRequestID and log.FromContext; the logger
+// carries the request ID, method and path.
+//
+// Returns:
+//   - Middleware: Request ID middleware
+//
+// Example:
+//
+//	httpServer.Use(server.RequestID())
+//	httpServer.HandleFunc("/api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+//	    log.FromContext(r.Context()).Info("Listing users")
+//	})
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			incoming := log.GetRequestID(r.Context())
+			if incoming == "" {
+				incoming = r.Header.Get(RequestIDHeader)
+			}
+			if !validRequestID(incoming) {
+				incoming = ""
+			}
+
+			ctx, _ := log.WithLogger(r.Context(), incoming,
+				log.Field{Key: "method", Value: r.Method},
+				log.Field{Key: "path", Value: r.URL.Path})
+			w.Header().Set(RequestIDHeader, log.GetRequestID(ctx))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AccessLog returns middleware that logs every request once it completes,
+// with its status, response size and duration. Requests are logged with the
+// request's context logger, so placing RequestID before AccessLog adds the
+// request ID. Server errors (5xx) are logged at error level, the rest at
+// info level.
+//
+// Returns:
+//   - Middleware: Access logging middleware
+//
+// Example:
+//
+//	httpServer.Use(server.RequestID(), server.AccessLog())
+func AccessLog() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := newStatusRecorder(w)
+			next.ServeHTTP(rec, r)
+
+			fields := []log.Field{
+				{Key: "method", Value: r.Method},
+				{Key: "path", Value: r.URL.Path},
+				{Key: "status", Value: rec.status},
+				{Key: "bytes", Value: rec.bytes},
+				{Key: "duration", Value: time.Since(start)},
+				{Key: "remote_addr", Value: r.RemoteAddr},
+			}
+			logger := log.FromContext(r.Context())
+			if rec.status >= http.StatusInternalServerError {
+				logger.Error("HTTP request completed", fields...)
+				return
+			}
+			logger.Info("HTTP request completed", fields...)
+		})
+	}
+}
+
+// Timeout returns middleware that bounds how long later handlers may run.
+// The request's context is canceled after timeout and the client receives
+// a 503 Service Unavailable response if the handler has not responded by
+// then. It is built on http.TimeoutHandler, so handlers behind it cannot
+// use http.Flusher or http.Hijacker.
+//
+// Parameters:
+//   - timeout: Maximum time to serve a request
+//
+// Returns:
+//   - Middleware: Timeout middleware
+//
+// Example:
+//
+//	httpServer.HandleFunc("/api/v1/reports", reportHandler, server.Timeout(10*time.Second))
+func Timeout(timeout time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, timeout, "request timed out")
+	}
+}
+
+// statusRecorder records the status code and size of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	// status is the response status code, 200 until WriteHeader is called
+	status int
+
+	// bytes counts the body bytes written
+	bytes int64
+
+	// wroteHeader is true once the header has been sent
+	wroteHeader bool
+}
+
+// newStatusRecorder wraps w.
+func newStatusRecorder(w http.ResponseWriter) *statusRecorder {
+	return &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+}
+
+// WriteHeader records the status code and sends the header. Informational
+// (1xx) headers are passed through without being recorded.
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader && status >= http.StatusOK {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the body size and writes to the wrapped writer.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += int64(n)
+	return n, err
+}
+
+// Flush flushes the wrapped writer if it supports flushing. A successful
+// flush sends the header, so the response counts as written.
+func (r *statusRecorder) Flush() {
+	if err := http.NewResponseController(r.ResponseWriter).Flush(); err == nil {
+		r.wroteHeader = true
+	}
+}
+
+// Hijack lets the handler take over the connection, e.g. for WebSockets.
+// It fails with http.ErrNotSupported if the wrapped writer cannot be
+// hijacked.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := http.NewResponseController(r.ResponseWriter).Hijack()
+	if err == nil {
+		// The handler owns the connection; nothing may be written to it
+		r.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
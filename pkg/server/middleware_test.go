@@ -0,0 +1,390 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// logEntry is a message captured by recordingLogger.
+type logEntry struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+// recordingLogger captures log entries, including fields added with With.
+type recordingLogger struct {
+	mu      *sync.Mutex
+	entries *[]logEntry
+	fields  []log.Field
+}
+
+func newRecordingLogger() *recordingLogger {
+	return &recordingLogger{mu: &sync.Mutex{}, entries: &[]logEntry{}}
+}
+
+func (l *recordingLogger) record(level, msg string, fields []log.Field) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := logEntry{level: level, msg: msg, fields: map[string]interface{}{}}
+	for _, f := range append(append([]log.Field{}, l.fields...), fields...) {
+		entry.fields[f.Key] = f.Value
+	}
+	*l.entries = append(*l.entries, entry)
+}
+
+func (l *recordingLogger) Debug(msg string, fields ...log.Field) { l.record("debug", msg, fields) }
+func (l *recordingLogger) Info(msg string, fields ...log.Field)  { l.record("info", msg, fields) }
+func (l *recordingLogger) Warn(msg string, fields ...log.Field)  { l.record("warn", msg, fields) }
+func (l *recordingLogger) Error(msg string, fields ...log.Field) { l.record("error", msg, fields) }
+func (l *recordingLogger) Fatal(msg string, fields ...log.Field) { l.record("fatal", msg, fields) }
+func (l *recordingLogger) Sync() error                           { return nil }
+
+func (l *recordingLogger) With(fields ...log.Field) log.Logger {
+	return &recordingLogger{mu: l.mu, entries: l.entries, fields: append(append([]log.Field{}, l.fields...), fields...)}
+}
+
+func (l *recordingLogger) find(msg string) (logEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range *l.entries {
+		if e.msg == msg {
+			return e, true
+		}
+	}
+	return logEntry{}, false
+}
+
+// withLogger is middleware attaching logger to the request context.
+func withLogger(logger log.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(log.WithContext(r.Context(), logger)))
+		})
+	}
+}
+
+// tag returns middleware appending name to the X-Trace response header.
+func tag(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Trace", name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func serve(h http.Handler, method, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func TestChain_Order(t *testing.T) {
+	handler := Chain(tag("a"), nil, tag("b"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("X-Trace", "handler")
+	}))
+
+	rec := serve(handler, http.MethodGet, "/")
+	assert.Equal(t, []string{"a", "b", "handler"}, rec.Header().Values("X-Trace"))
+
+	rec = serve(Chain()(http.NotFoundHandler()), http.MethodGet, "/")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHTTPServer_Use(t *testing.T) {
+	server := NewHTTPServer(":8080")
+	server.HandleFunc("/before", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("X-Trace", "handler")
+	})
+
+	server.Use(tag("global-1"))
+	server.Use(tag("global-2"))
+
+	server.HandleFunc("/after", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("X-Trace", "handler")
+	}, tag("route-1"), tag("route-2"))
+
+	rec := serve(server, http.MethodGet, "/before")
+	assert.Equal(t, []string{"global-1", "global-2", "handler"}, rec.Header().Values("X-Trace"))
+
+	rec = serve(server, http.MethodGet, "/after")
+	assert.Equal(t, []string{"global-1", "global-2", "route-1", "route-2", "handler"}, rec.Header().Values("X-Trace"))
+
+	// Unmatched requests also pass through the global chain
+	rec = serve(server, http.MethodGet, "/missing")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, []string{"global-1", "global-2"}, rec.Header().Values("X-Trace"))
+
+	// The http.Server serves through the chain too
+	rec = serve(server.GetServer().Handler, http.MethodGet, "/before")
+	assert.Equal(t, []string{"global-1", "global-2", "handler"}, rec.Header().Values("X-Trace"))
+}
+
+func TestHTTPServer_HandlePerRoute(t *testing.T) {
+	server := NewHTTPServer(":8080")
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	server.Handle("/plain", handler)
+	server.Handle("/tagged", handler, tag("route"))
+
+	assert.Empty(t, serve(server, http.MethodGet, "/plain").Header().Values("X-Trace"))
+	assert.Equal(t, []string{"route"}, serve(server, http.MethodGet, "/tagged").Header().Values("X-Trace"))
+}
+
+func TestRecovery(t *testing.T) {
+	logger := newRecordingLogger()
+	handler := Chain(withLogger(logger), Recovery())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := serve(handler, http.MethodGet, "/panic")
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	entry, ok := logger.find("HTTP handler panicked")
+	require.True(t, ok)
+	assert.Equal(t, "error", entry.level)
+	assert.Equal(t, "boom", entry.fields["panic"])
+	assert.Equal(t, "/panic", entry.fields["path"])
+	assert.Contains(t, entry.fields["stack"], "panic")
+}
+
+func TestRecovery_RequestIDFallback(t *testing.T) {
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	// RequestID after Recovery: the ID it echoed in the response is logged
+	logger := newRecordingLogger()
+	rec := serve(Chain(withLogger(logger), Recovery(), RequestID())(panicking), http.MethodGet, "/")
+	entry, ok := logger.find("HTTP handler panicked")
+	require.True(t, ok)
+	assert.NotEmpty(t, rec.Header().Get(RequestIDHeader))
+	assert.Equal(t, rec.Header().Get(RequestIDHeader), entry.fields["request_id"])
+
+	// Without RequestID, a valid request header is logged
+	logger = newRecordingLogger()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "client-9")
+	Chain(withLogger(logger), Recovery())(panicking).ServeHTTP(httptest.NewRecorder(), req)
+	entry, ok = logger.find("HTTP handler panicked")
+	require.True(t, ok)
+	assert.Equal(t, "client-9", entry.fields["request_id"])
+
+	// Invalid IDs are not logged
+	logger = newRecordingLogger()
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "bad id")
+	Chain(withLogger(logger), Recovery())(panicking).ServeHTTP(httptest.NewRecorder(), req)
+	entry, ok = logger.find("HTTP handler panicked")
+	require.True(t, ok)
+	assert.NotContains(t, entry.fields, "request_id")
+}
+
+func TestRecovery_AfterHeaderWritten(t *testing.T) {
+	handler := Chain(withLogger(newRecordingLogger()), Recovery())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("partial"))
+		panic("late")
+	}))
+
+	rec := serve(handler, http.MethodGet, "/")
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, "partial", rec.Body.String())
+}
+
+func TestRecovery_AfterFlush(t *testing.T) {
+	handler := Chain(withLogger(newRecordingLogger()), Recovery())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("streamed"))
+		w.(http.Flusher).Flush()
+		panic("mid-stream")
+	}))
+
+	rec := serve(handler, http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "streamed", rec.Body.String(), "No error is appended to a committed response")
+
+	// Flushing before any write commits the header too
+	handler = Chain(withLogger(newRecordingLogger()), Recovery())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+		panic("after flush")
+	}))
+	rec = serve(handler, http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestRecovery_AbortHandler(t *testing.T) {
+	handler := Recovery()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		serve(handler, http.MethodGet, "/")
+	})
+}
+
+func TestRequestID(t *testing.T) {
+	logger := newRecordingLogger()
+	previous := log.Default()
+	log.SetDefault(logger)
+	defer log.SetDefault(previous)
+
+	var ids []string
+	handler := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := log.GetRequestID(r.Context())
+		ids = append(ids, id)
+		log.FromContext(r.Context()).Info("Handling request")
+	}))
+
+	serve(handler, http.MethodGet, "/orders")
+	serve(handler, http.MethodGet, "/orders")
+
+	require.Len(t, ids, 2)
+	assert.NotEmpty(t, ids[0])
+	assert.NotEqual(t, ids[0], ids[1], "Each request gets its own ID")
+
+	entry, ok := logger.find("Handling request")
+	require.True(t, ok)
+	assert.Equal(t, ids[0], entry.fields["request_id"])
+	assert.Equal(t, http.MethodGet, entry.fields["method"])
+	assert.Equal(t, "/orders", entry.fields["path"])
+}
+
+func TestAccessLog(t *testing.T) {
+	logger := newRecordingLogger()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	})
+	mux.HandleFunc("/fail", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	handler := Chain(withLogger(logger), AccessLog())(mux)
+
+	serve(handler, http.MethodGet, "/ok")
+	entry, ok := logger.find("HTTP request completed")
+	require.True(t, ok)
+	assert.Equal(t, "info", entry.level)
+	assert.Equal(t, http.StatusOK, entry.fields["status"])
+	assert.Equal(t, int64(5), entry.fields["bytes"])
+	assert.Equal(t, "/ok", entry.fields["path"])
+	assert.IsType(t, time.Duration(0), entry.fields["duration"])
+
+	logger = newRecordingLogger()
+	handler = Chain(withLogger(logger), AccessLog())(mux)
+	serve(handler, http.MethodPost, "/fail")
+	entry, ok = logger.find("HTTP request completed")
+	require.True(t, ok)
+	assert.Equal(t, "error", entry.level)
+	assert.Equal(t, http.StatusBadGateway, entry.fields["status"])
+	assert.Equal(t, http.MethodPost, entry.fields["method"])
+}
+
+func TestTimeout(t *testing.T) {
+	canceled := make(chan struct{})
+	handler := Timeout(20 * time.Millisecond)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			close(canceled)
+		case <-time.After(time.Second):
+		}
+	}))
+
+	rec := serve(handler, http.MethodGet, "/slow")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "request timed out", rec.Body.String())
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("Handler context was not canceled")
+	}
+
+	fast := Timeout(time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("done"))
+	}))
+	rec = serve(fast, http.MethodGet, "/fast")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "done", rec.Body.String())
+}
+
+func TestStatusRecorder_Flush(t *testing.T) {
+	rec := httptest.NewRecorder()
+	recorder := newStatusRecorder(rec)
+
+	recorder.WriteHeader(http.StatusContinue)
+	recorder.WriteHeader(http.StatusCreated)
+	recorder.Flush()
+
+	assert.Equal(t, http.StatusCreated, recorder.status)
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, rec, recorder.Unwrap())
+}
+
+func TestStatusRecorder_Hijack(t *testing.T) {
+	logger := newRecordingLogger()
+	upstream := httptest.NewServer(Chain(withLogger(logger), Recovery(), AccessLog())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		_, _ = rw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+		_ = rw.Flush()
+		panic("after hijack")
+	})))
+	defer upstream.Close()
+
+	resp, err := http.Get(upstream.URL)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "hijacked", string(body))
+
+	// A panic after hijacking is logged without writing an error response
+	require.Eventually(t, func() bool {
+		_, ok := logger.find("HTTP handler panicked")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// Writers that cannot be hijacked report it
+	_, _, err = newStatusRecorder(httptest.NewRecorder()).Hijack()
+	assert.ErrorIs(t, err, http.ErrNotSupported)
+}
+
+func TestHTTPServer_MiddlewareServing(t *testing.T) {
+	server := NewHTTPServer("127.0.0.1:0")
+	listener := httptest.NewServer(server)
+	defer listener.Close()
+
+	server.Use(RequestID(), Recovery(), AccessLog())
+	server.HandleFunc("/id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(log.GetRequestID(r.Context())))
+	})
+	server.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) {
+		panic("handler bug")
+	})
+
+	resp, err := http.Get(listener.URL + "/id")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEmpty(t, string(body))
+
+	resp, err = http.Get(listener.URL + "/panic")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get(RequestIDHeader), "Panics are answered with the request ID")
+}
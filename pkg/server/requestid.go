@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the request ID
+	RequestIDHeader = "X-Request-ID"
+
+	// RequestIDMetadataKey is the gRPC metadata key carrying the request ID
+	RequestIDMetadataKey = "x-request-id"
+
+	// maxRequestIDLength bounds incoming request IDs so clients cannot
+	// inflate every log line of a request
+	maxRequestIDLength = 128
+)
+
+// validRequestID reports whether an incoming request ID is safe to reuse:
+// non-empty, at most maxRequestIDLength bytes and printable ASCII without
+// spaces.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestIDUnaryServerInterceptor returns a gRPC interceptor that gives each
+// unary call an ID and a request-scoped logger created with log.WithLogger.
+// The ID is taken from the x-request-id metadata when it holds a valid ID,
+// and generated otherwise. It is echoed in the x-request-id response header.
+// The logger carries the request ID and the full method name.
+//
+// Returns:
+//   - grpc.UnaryServerInterceptor: Request ID interceptor
+//
+// Example:
+//
+//	grpcServer := server.NewGRPCServerWithOptions(":9090",
+//	    grpc.ChainUnaryInterceptor(server.RequestIDUnaryServerInterceptor()),
+//	    grpc.ChainStreamInterceptor(server.RequestIDStreamServerInterceptor()))
+func RequestIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = withIncomingRequestID(ctx, info.FullMethod)
+
+		// Fails only outside a real call, e.g. when the interceptor is called directly
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, log.GetRequestID(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// RequestIDStreamServerInterceptor returns a gRPC interceptor that gives
+// each streaming call an ID and a request-scoped logger, like
+// RequestIDUnaryServerInterceptor. Handlers read them from the stream's
+// context.
+//
+// Returns:
+//   - grpc.StreamServerInterceptor: Request ID interceptor
+func RequestIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withIncomingRequestID(ss.Context(), info.FullMethod)
+		_ = ss.SetHeader(metadata.Pairs(RequestIDMetadataKey, log.GetRequestID(ctx)))
+		return handler(srv, &requestIDServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withIncomingRequestID attaches the request ID already in ctx, from the
+// incoming metadata or a new one, and a request-scoped logger to ctx.
+func withIncomingRequestID(ctx context.Context, method string) context.Context {
+	incoming := log.GetRequestID(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok && incoming == "" {
+		for _, id := range md.Get(RequestIDMetadataKey) {
+			if validRequestID(id) {
+				incoming = id
+				break
+			}
+		}
+	}
+
+	ctx, _ = log.WithLogger(ctx, incoming, log.Field{Key: "method", Value: method})
+	return ctx
+}
+
+// requestIDServerStream overrides the context of a server stream.
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the request ID and logger.
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RequestIDUnaryClientInterceptor returns a gRPC client interceptor that
+// forwards the request ID from the call's context (see log.GetRequestID)
+// in the x-request-id metadata, so the called service logs under the same
+// ID. Calls without a request ID, or with x-request-id already set, are
+// left unchanged.
+//
+// Returns:
+//   - grpc.UnaryClientInterceptor: Request ID forwarding interceptor
+//
+// Example:
+//
+//	conn, err := grpc.NewClient("orders:9090",
+//	    grpc.WithTransportCredentials(insecure.NewCredentials()),
+//	    grpc.WithChainUnaryInterceptor(server.RequestIDUnaryClientInterceptor()),
+//	    grpc.WithChainStreamInterceptor(server.RequestIDStreamClientInterceptor()))
+func RequestIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withOutgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// RequestIDStreamClientInterceptor returns a gRPC client interceptor that
+// forwards the request ID on streaming calls, like
+// RequestIDUnaryClientInterceptor.
+//
+// Returns:
+//   - grpc.StreamClientInterceptor: Request ID forwarding interceptor
+func RequestIDStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withOutgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+// withOutgoingRequestID adds the context's request ID to the outgoing
+// metadata unless it is missing or already set.
+func withOutgoingRequestID(ctx context.Context) context.Context {
+	id := log.GetRequestID(ctx)
+	if id == "" {
+		return ctx
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(RequestIDMetadataKey)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, id)
+}
+
+// requestIDTransport forwards the request ID on outgoing HTTP requests.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+// RequestIDTransport returns an http.RoundTripper that forwards the request
+// ID from each request's context (see log.GetRequestID) in the X-Request-ID
+// header, so the called service logs under the same ID. Requests without a
+// request ID, or with the header already set, are sent unchanged.
+//
+// Parameters:
+//   - base: Transport that sends the requests; nil means http.DefaultTransport
+//
+// Returns:
+//   - http.RoundTripper: Request ID forwarding transport
+//
+// Example:
+//
+//	client := &http.Client{Transport: server.RequestIDTransport(nil)}
+//	req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, "http://inventory/api/v1/stock", nil)
+//	resp, err := client.Do(req)
+func RequestIDTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &requestIDTransport{base: base}
+}
+
+// RoundTrip sends req with the X-Request-ID header set from its context.
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if id := log.GetRequestID(req.Context()); id != "" && req.Header.Get(RequestIDHeader) == "" {
+		// RoundTrippers must not modify the caller's request
+		req = req.Clone(req.Context())
+		req.Header.Set(RequestIDHeader, id)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+func TestValidRequestID(t *testing.T) {
+	assert.True(t, validRequestID("3f2b9c1e-7a4d-4c55-9b7e-1d2f3a4b5c6d"))
+	assert.True(t, validRequestID("req_42"))
+	assert.False(t, validRequestID(""))
+	assert.False(t, validRequestID("has space"))
+	assert.False(t, validRequestID("line\nbreak"))
+	assert.False(t, validRequestID(strings.Repeat("a", maxRequestIDLength+1)))
+}
+
+func TestRequestID_PropagatesIncomingHeader(t *testing.T) {
+	var seen string
+	handler := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = log.GetRequestID(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set(RequestIDHeader, "upstream-123")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, "upstream-123", seen)
+	assert.Equal(t, "upstream-123", rec.Header().Get(RequestIDHeader))
+
+	// Invalid incoming IDs are replaced
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set(RequestIDHeader, "bad id")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.NotEqual(t, "bad id", seen)
+	assert.NotEmpty(t, seen)
+	assert.Equal(t, seen, rec.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_ReusesContextID(t *testing.T) {
+	var seen []string
+	handler := Chain(RequestID(), RequestID())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, log.GetRequestID(r.Context()))
+	}))
+
+	rec := serve(handler, http.MethodGet, "/")
+	require.Len(t, seen, 1)
+	assert.Equal(t, seen[0], rec.Header().Get(RequestIDHeader), "A second RequestID keeps the first ID")
+}
+
+func TestRequestIDTransport(t *testing.T) {
+	var received []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(RequestIDHeader))
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: RequestIDTransport(nil)}
+	ctx, _ := log.WithRequestID(context.Background(), "req-7")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header.Get(RequestIDHeader), "The caller's request is not modified")
+
+	// An explicit header wins
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(RequestIDHeader, "explicit")
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// No request ID in the context, nothing forwarded
+	req, err = http.NewRequest(http.MethodGet, upstream.URL, nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"req-7", "explicit", ""}, received)
+}
+
+// requestIDRecorder records the request ID seen by gRPC handlers.
+type requestIDRecorder struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *requestIDRecorder) add(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, log.GetRequestID(ctx))
+}
+
+func (r *requestIDRecorder) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.ids) == 0 {
+		return ""
+	}
+	return r.ids[len(r.ids)-1]
+}
+
+// startRequestIDServer serves the gRPC health service over an in-memory
+// connection with the request ID interceptors, and returns a client
+// connection using the forwarding client interceptors.
+func startRequestIDServer(t *testing.T, recorder *requestIDRecorder) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(RequestIDUnaryServerInterceptor(),
+			func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				recorder.add(ctx)
+				return handler(ctx, req)
+			}),
+		grpc.ChainStreamInterceptor(RequestIDStreamServerInterceptor(),
+			func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				recorder.add(ss.Context())
+				return handler(srv, ss)
+			}),
+	)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(RequestIDUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(RequestIDStreamClientInterceptor()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestRequestIDInterceptors_Unary(t *testing.T) {
+	recorder := &requestIDRecorder{}
+	client := healthpb.NewHealthClient(startRequestIDServer(t, recorder))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The client forwards the caller's ID and the server echoes it
+	callCtx, _ := log.WithRequestID(ctx, "order-42")
+	var header metadata.MD
+	_, err := client.Check(callCtx, &healthpb.HealthCheckRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+	assert.Equal(t, "order-42", recorder.last())
+	assert.Equal(t, []string{"order-42"}, header.Get(RequestIDMetadataKey))
+
+	// Without an ID the server generates one
+	header = nil
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+	generated := recorder.last()
+	assert.NotEmpty(t, generated)
+	assert.NotEqual(t, "order-42", generated)
+	assert.Equal(t, []string{generated}, header.Get(RequestIDMetadataKey))
+
+	// Explicit outgoing metadata wins over the context's ID
+	explicitCtx := metadata.AppendToOutgoingContext(callCtx, RequestIDMetadataKey, "explicit")
+	_, err = client.Check(explicitCtx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "explicit", recorder.last())
+}
+
+func TestRequestIDInterceptors_Stream(t *testing.T) {
+	recorder := &requestIDRecorder{}
+	client := healthpb.NewHealthClient(startRequestIDServer(t, recorder))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx, _ = log.WithRequestID(ctx, "watch-1")
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	header, err := stream.Header()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"watch-1"}, header.Get(RequestIDMetadataKey))
+	assert.Equal(t, "watch-1", recorder.last())
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ServiceOption configures a service registered with Launcher.AddNamedService.
+type ServiceOption func(*managedService)
+
+// DependsOn declares that a service must not start until the named services
+// are ready, and must stop before them. Dependencies may be registered
+// before or after the dependent service, but must all be registered by the
+// time the launcher runs.
+//
+// Parameters:
+//   - names: Names of the services this service depends on
+//
+// Returns:
+//   - ServiceOption: Option for AddNamedService
+//
+// Example:
+//
+//	launcher.AddNamedService("cache-warmer", warmer)
+//	launcher.AddNamedService("http", httpServer, service.DependsOn("cache-warmer"))
+func DependsOn(names ...string) ServiceOption {
+	return func(m *managedService) {
+		m.dependsOn = append(m.dependsOn, names...)
+	}
+}
+
+// managedService is a registered service with its name, dependencies,
+// restart policy and per-run lifecycle signals.
+type managedService struct {
+	// name identifies the service in logs, errors and dependency declarations
+	name string
+
+	// svc is the wrapped service
+	svc Service
+
+	// dependsOn lists the names of services that must be ready first
+	dependsOn []string
+
+	// restart is the supervision policy applied when Start returns
+	restart RestartPolicy
+
+	// stopTimeout is the stop budget; zero uses the launcher default
+	stopTimeout time.Duration
+
+	// exited is closed once the service has finished for the current run,
+	// including restarts; it is closed while no run is in progress
+	exited chan struct{}
+
+	// ctx is passed to Start; it is canceled when this service is stopped
+	ctx context.Context
+
+	// cancel cancels ctx
+	cancel context.CancelFunc
+
+	// stopping is set once shutdown has begun stopping this service, so
+	// that a Start returning because of Stop is not restarted
+	stopping atomic.Bool
+
+	// removed is set once the service is removed with RemoveService, so
+	// that its exit is not treated as a failure
+	removed atomic.Bool
+
+	// mu protects ready, readyClosed and the status fields below
+	mu sync.Mutex
+
+	// ready is closed once the service reports ready; it is replaced when
+	// the service is restarted
+	ready chan struct{}
+
+	// readyClosed reports whether ready has been closed
+	readyClosed bool
+
+	// state is the lifecycle state reported by Status
+	state ServiceState
+
+	// startedAt is when Start was last called
+	startedAt time.Time
+
+	// restarts counts the restarts in the current run
+	restarts int
+
+	// lastErr is the latest error returned by Start
+	lastErr error
+}
+
+// newManagedService wraps svc under name and applies opts.
+func newManagedService(name string, svc Service, opts []ServiceOption) *managedService {
+	m := &managedService{
+		name:   name,
+		svc:    svc,
+		ready:  make(chan struct{}),
+		exited: make(chan struct{}),
+		ctx:    context.Background(),
+		cancel: func() {},
+		state:  StatePending,
+	}
+	close(m.exited)
+	for _, opt := range opts {
+		if opt != nil {
+			opt(m)
+		}
+	}
+	return m
+}
+
+// reset prepares the lifecycle signals for a new run whose services are
+// canceled individually. Values from parent are kept, but its cancellation
+// is not inherited: the launcher cancels each service in dependency order.
+func (m *managedService) reset(parent context.Context) {
+	m.resetReady()
+	m.mu.Lock()
+	m.state = StatePending
+	m.startedAt = time.Time{}
+	m.restarts = 0
+	m.lastErr = nil
+	m.mu.Unlock()
+	m.exited = make(chan struct{})
+	m.stopping.Store(false)
+	m.ctx, m.cancel = context.WithCancel(context.WithoutCancel(parent))
+}
+
+// resetReady marks the service as not ready, e.g. before a restart.
+func (m *managedService) resetReady() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.readyClosed {
+		m.ready = make(chan struct{})
+		m.readyClosed = false
+	}
+}
+
+// readyChan returns the channel closed when the service becomes ready.
+func (m *managedService) readyChan() <-chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ready
+}
+
+// markReady records that the service is ready; it is safe to call repeatedly.
+func (m *managedService) markReady() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.readyClosed {
+		close(m.ready)
+		m.readyClosed = true
+	}
+	if m.state == StateStarting {
+		m.state = StateRunning
+	}
+}
+
+// isReady reports whether the service has reported ready in the current run.
+func (m *managedService) isReady() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.readyClosed
+}
+
+// findService returns the registered service with the given name, or nil.
+func (l *Launcher) findService(name string) *managedService {
+	for _, m := range l.services {
+		if m.name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// checkCycle reports an error if registering m would create a dependency
+// cycle among the registered services.
+func (l *Launcher) checkCycle(m *managedService) error {
+	return dependencyCycle(m.name, func(name string) []string {
+		if name == m.name {
+			return m.dependsOn
+		}
+		if existing := l.findService(name); existing != nil {
+			return existing.dependsOn
+		}
+		return nil
+	})
+}
+
+// dependencyCycle reports an error if start is reachable from itself in the
+// dependency graph described by deps.
+func dependencyCycle(start string, deps func(name string) []string) error {
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		path = append(path, name)
+		for _, dep := range deps(name) {
+			if dep == start {
+				path = append(path, dep)
+				return true
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			if visit(dep) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if visit(start) {
+		return fmt.Errorf("dependency cycle: %s", strings.Join(path, " -> "))
+	}
+	return nil
+}
+
+// checkDependencies reports an error if any service depends on a service
+// that was never registered.
+func (l *Launcher) checkDependencies() error {
+	for _, m := range l.services {
+		for _, dep := range m.dependsOn {
+			if l.findService(dep) == nil {
+				return fmt.Errorf("service %s depends on unknown service %s", m.name, dep)
+			}
+		}
+	}
+	return nil
+}
+
+// startOrder returns the services in dependency order: every service comes
+// after the services it depends on, and otherwise registration order is kept.
+// Unknown dependencies are ignored; see checkDependencies.
+func (l *Launcher) startOrder() []*managedService {
+	placed := make(map[string]bool, len(l.services))
+	order := make([]*managedService, 0, len(l.services))
+
+	for len(order) < len(l.services) {
+		progressed := false
+		for _, m := range l.services {
+			if placed[m.name] || !l.dependenciesPlaced(m, placed) {
+				continue
+			}
+			placed[m.name] = true
+			order = append(order, m)
+			progressed = true
+			// Restart the scan so earlier-registered services keep priority
+			break
+		}
+		if !progressed {
+			// Unreachable: cycles are rejected at registration
+			for _, m := range l.services {
+				if !placed[m.name] {
+					placed[m.name] = true
+					order = append(order, m)
+				}
+			}
+		}
+	}
+	return order
+}
+
+// dependenciesPlaced reports whether every registered dependency of m is placed.
+func (l *Launcher) dependenciesPlaced(m *managedService, placed map[string]bool) bool {
+	for _, dep := range m.dependsOn {
+		if !placed[dep] && l.findService(dep) != nil {
+			return false
+		}
+	}
+	return true
+}
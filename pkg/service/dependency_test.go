@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventLog records lifecycle events from several services in order.
+type eventLog struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (e *eventLog) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, event)
+}
+
+func (e *eventLog) list() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.events...)
+}
+
+// readyService is a blocking service that reports ready after readyDelay.
+type readyService struct {
+	name       string
+	log        *eventLog
+	readyDelay time.Duration
+	exitEarly  bool
+	ready      chan struct{}
+}
+
+func newReadyService(name string, log *eventLog, readyDelay time.Duration) *readyService {
+	return &readyService{name: name, log: log, readyDelay: readyDelay, ready: make(chan struct{})}
+}
+
+func (s *readyService) Ready() <-chan struct{} {
+	return s.ready
+}
+
+func (s *readyService) Start(ctx context.Context) error {
+	s.log.add("start " + s.name)
+	if s.exitEarly {
+		return nil
+	}
+	time.Sleep(s.readyDelay)
+	s.log.add("ready " + s.name)
+	close(s.ready)
+	<-ctx.Done()
+	return nil
+}
+
+func (s *readyService) Stop(ctx context.Context) error {
+	s.log.add("stop " + s.name)
+	return nil
+}
+
+// TestAddNamedService_Validation tests registration checks.
+// This verifies empty names, duplicates and dependency cycles are rejected.
+func TestAddNamedService_Validation(t *testing.T) {
+	launcher := NewLauncher()
+
+	assert.Error(t, launcher.AddNamedService("", newMockService("svc")))
+	assert.Error(t, launcher.AddNamedService("nil", nil))
+
+	require.NoError(t, launcher.AddNamedService("a", newMockService("a"), DependsOn("b")))
+	require.NoError(t, launcher.AddNamedService("b", newMockService("b"), DependsOn("c")))
+
+	err := launcher.AddNamedService("a", newMockService("a"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+
+	err = launcher.AddNamedService("c", newMockService("c"), DependsOn("a"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle: c -> a -> b -> c")
+
+	err = launcher.AddNamedService("self", newMockService("self"), DependsOn("self"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle: self -> self")
+
+	assert.Len(t, launcher.services, 2, "Rejected services must not be registered")
+}
+
+// TestStartServices_DependencyOrder tests dependency-aware startup and shutdown.
+// This verifies dependents start after dependencies are ready and stop before them.
+func TestStartServices_DependencyOrder(t *testing.T) {
+	events := &eventLog{}
+	launcher := NewLauncher()
+
+	// Registered before its dependency to show ordering is not by registration
+	require.NoError(t, launcher.AddNamedService("http", newReadyService("http", events, 0), DependsOn("cache")))
+	require.NoError(t, launcher.AddNamedService("cache", newReadyService("cache", events, 50*time.Millisecond)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- launcher.startServices(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(events.list()) >= 4
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.Equal(t, []string{
+		"start cache",
+		"ready cache",
+		"start http",
+		"ready http",
+		"stop http",
+		"stop cache",
+	}, events.list())
+}
+
+// TestStartServices_UnknownDependency tests validation of missing dependencies.
+// This verifies the launcher refuses to start when a dependency is not registered.
+func TestStartServices_UnknownDependency(t *testing.T) {
+	launcher := NewLauncher()
+	svc := newMockService("http")
+	require.NoError(t, launcher.AddNamedService("http", svc, DependsOn("cache")))
+
+	err := launcher.Run(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "service http depends on unknown service cache")
+	assert.Zero(t, svc.startCalled)
+}
+
+// TestStartServices_DependencyExitsBeforeReady tests a dependency that never becomes ready.
+// This verifies dependents are not started and the launcher does not hang.
+func TestStartServices_DependencyExitsBeforeReady(t *testing.T) {
+	events := &eventLog{}
+	launcher := NewLauncher()
+
+	cache := newReadyService("cache", events, 0)
+	cache.exitEarly = true
+	require.NoError(t, launcher.AddNamedService("cache", cache))
+	require.NoError(t, launcher.AddNamedService("http", newReadyService("http", events, 0), DependsOn("cache")))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- launcher.startServices(context.Background())
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Launcher hung waiting for a dependency that exited")
+	}
+	assert.NotContains(t, events.list(), "start http")
+}
+
+// TestStartOrder_KeepsRegistrationOrder tests ordering without dependencies.
+// This verifies unrelated services keep registration order.
+func TestStartOrder_KeepsRegistrationOrder(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.AddService(newMockService("a"))
+	require.NoError(t, launcher.AddNamedService("b", newMockService("b"), DependsOn("d")))
+	require.NoError(t, launcher.AddNamedService("c", newMockService("c")))
+	require.NoError(t, launcher.AddNamedService("d", newMockService("d")))
+
+	var names []string
+	for _, m := range launcher.startOrder() {
+		names = append(names, m.name)
+	}
+
+	assert.Equal(t, []string{"*service.mockService#0", "c", "d", "b"}, names)
+}
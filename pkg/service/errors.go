@@ -0,0 +1,53 @@
+package service
+
+import "fmt"
+
+// ServiceError reports a registered service that failed while running.
+// Launcher.Run returns it, joined with any errors from stopping the other
+// services, so callers can find the failed service with errors.As.
+//
+// Example:
+//
+//	var svcErr *service.ServiceError
+//	if errors.As(err, &svcErr) {
+//	    log.Error("Service failed",
+//	        log.Field{Key: "service", Value: svcErr.Name},
+//	        log.Field{Key: "error", Value: svcErr.Err})
+//	}
+type ServiceError struct {
+	// Name is the name of the failed service
+	Name string
+
+	// Err is the error returned by the service
+	Err error
+}
+
+// Error returns the failure as "service NAME failed: cause".
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("service %s failed: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the error returned by the service.
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// StopError reports a service that failed to stop during shutdown.
+type StopError struct {
+	// Name is the name of the service
+	Name string
+
+	// Err is the error from Stop, or the stop context's error if the
+	// service was abandoned after exceeding its stop budget
+	Err error
+}
+
+// Error returns the failure as "service NAME failed to stop: cause".
+func (e *StopError) Error() string {
+	return fmt.Sprintf("service %s failed to stop: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying stop error.
+func (e *StopError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// Hook is a function run by the launcher at a lifecycle point.
+// See OnBeforeInit, OnAfterInit, OnStarted, OnBeforeStop and OnStopped
+// for when each kind runs and how its errors are handled.
+type Hook func(ctx context.Context) error
+
+// lifecycleHooks holds the hooks registered for each lifecycle point.
+type lifecycleHooks struct {
+	beforeInit []Hook
+	afterInit  []Hook
+	started    []Hook
+	beforeStop []Hook
+	stopped    []Hook
+}
+
+// OnBeforeInit registers hooks that Run calls before the initializers.
+// Hooks run in registration order with Run's context. An error aborts
+// Run before anything is initialized; the remaining hooks are skipped and
+// OnStopped hooks do not run.
+//
+// Parameters:
+//   - hooks: Hooks to run
+func (l *Launcher) OnBeforeInit(hooks ...Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks.beforeInit = append(l.hooks.beforeInit, hooks...)
+}
+
+// OnAfterInit registers hooks that Run calls once all initializers have
+// succeeded, before any service starts. Hooks run in registration order
+// with Run's context. An error aborts Run; the remaining hooks are skipped.
+//
+// Parameters:
+//   - hooks: Hooks to run
+func (l *Launcher) OnAfterInit(hooks ...Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks.afterInit = append(l.hooks.afterInit, hooks...)
+}
+
+// OnStarted registers hooks that Run calls once every service has started
+// and reported ready, e.g. to announce the instance to a service registry.
+// Hooks run in registration order with Run's context. An error is treated
+// like a service failure: the remaining hooks are skipped, all services are
+// stopped and Run returns the error.
+//
+// Parameters:
+//   - hooks: Hooks to run
+//
+// Example:
+//
+//	launcher.OnStarted(func(ctx context.Context) error {
+//	    return registry.Register(ctx, instance)
+//	})
+func (l *Launcher) OnStarted(hooks ...Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks.started = append(l.hooks.started, hooks...)
+}
+
+// OnBeforeStop registers hooks that the launcher calls when it begins
+// stopping services, after any drain period and before the first service
+// is stopped, e.g. to deregister from a service registry. Hooks run in
+// registration order with a context bounded by the shutdown timeout. All
+// hooks run even if some fail; errors are returned from Run joined with
+// the other shutdown errors.
+//
+// Parameters:
+//   - hooks: Hooks to run
+func (l *Launcher) OnBeforeStop(hooks ...Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks.beforeStop = append(l.hooks.beforeStop, hooks...)
+}
+
+// OnStopped registers hooks that Run calls just before it returns, after
+// all services have stopped, e.g. to close connections or flush buffers.
+// They also run if initialization or an OnAfterInit hook fails. Hooks run
+// in registration order with a context bounded by the shutdown timeout
+// that is not canceled with Run's context. All hooks run even if some fail;
+// errors are returned from Run joined with its other errors.
+//
+// Parameters:
+//   - hooks: Hooks to run
+//
+// Example:
+//
+//	launcher.OnStopped(func(ctx context.Context) error {
+//	    return producer.Flush(ctx)
+//	})
+func (l *Launcher) OnStopped(hooks ...Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks.stopped = append(l.hooks.stopped, hooks...)
+}
+
+// runHooks calls hooks in order. If stopOnError is set it returns the
+// first error; otherwise it calls every hook and returns all errors joined.
+func (l *Launcher) runHooks(ctx context.Context, phase string, hooks []Hook, stopOnError bool) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	l.logger.Debug("Running lifecycle hooks",
+		log.Field{Key: "phase", Value: phase},
+		log.Field{Key: "count", Value: len(hooks)})
+
+	var errs []error
+	for i, hook := range hooks {
+		start := time.Now()
+		if err := hook(ctx); err != nil {
+			l.logger.Error("Lifecycle hook failed",
+				log.Field{Key: "phase", Value: phase},
+				log.Field{Key: "index", Value: i},
+				log.Field{Key: "duration", Value: time.Since(start)},
+				log.Field{Key: "error", Value: err})
+			err = fmt.Errorf("%s hook %d failed: %w", phase, i, err)
+			if stopOnError {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// hooksFor returns a copy of the hooks selected by pick.
+func (l *Launcher) hooksFor(pick func(*lifecycleHooks) []Hook) []Hook {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return append([]Hook(nil), pick(&l.hooks)...)
+}
+
+// runStoppedHooks calls the OnStopped hooks with a fresh context bounded
+// by the shutdown timeout.
+func (l *Launcher) runStoppedHooks(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), l.shutdownTimeout)
+	defer cancel()
+
+	hooks := l.hooksFor(func(h *lifecycleHooks) []Hook { return h.stopped })
+	return l.runHooks(ctx, "stopped", hooks, false)
+}
+
+// awaitStarted waits until every service is ready, then calls the
+// OnStarted hooks and sends their result on result. It gives up without
+// sending if ctx is canceled or all services exit first.
+func (l *Launcher) awaitStarted(ctx context.Context, allExited <-chan struct{}, result chan<- error) {
+	l.mu.RLock()
+	ready := make([]<-chan struct{}, len(l.services))
+	for i, m := range l.services {
+		ready[i] = m.readyChan()
+	}
+	l.mu.RUnlock()
+
+	for _, ch := range ready {
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return
+		case <-allExited:
+			return
+		}
+	}
+
+	l.logger.Info("All services started",
+		log.Field{Key: "service_count", Value: len(ready)})
+
+	hooks := l.hooksFor(func(h *lifecycleHooks) []Hook { return h.started })
+	result <- l.runHooks(ctx, "started", hooks, true)
+}
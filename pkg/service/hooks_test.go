@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHook logs name when called and returns err.
+func recordingHook(name string, log *eventLog, err error) Hook {
+	return func(ctx context.Context) error {
+		log.add(name)
+		return err
+	}
+}
+
+// TestRun_HookOrder tests when each kind of lifecycle hook runs.
+// This verifies hooks run around initialization, startup and shutdown in order.
+func TestRun_HookOrder(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, launcher.AddNamedInitializer("config", recordingInit("config", events, 0)))
+	require.NoError(t, launcher.AddNamedService("api", newReadyService("api", events, 10*time.Millisecond)))
+
+	launcher.OnBeforeInit(recordingHook("before-init", events, nil))
+	launcher.OnAfterInit(recordingHook("after-init", events, nil))
+	launcher.OnStarted(recordingHook("started", events, nil), func(ctx context.Context) error {
+		cancel()
+		return nil
+	})
+	launcher.OnBeforeStop(recordingHook("before-stop", events, nil))
+	launcher.OnStopped(recordingHook("stopped 1", events, nil), recordingHook("stopped 2", events, nil))
+
+	require.NoError(t, launcher.Run(ctx))
+	assert.Equal(t, []string{
+		"before-init",
+		"start config", "done config",
+		"after-init",
+		"start api", "ready api",
+		"started",
+		"before-stop",
+		"stop api",
+		"stopped 1", "stopped 2",
+	}, events.list())
+}
+
+// TestRun_InitHookErrors tests failing OnBeforeInit and OnAfterInit hooks.
+// This verifies Run aborts before starting services and runs OnStopped hooks only after initialization began.
+func TestRun_InitHookErrors(t *testing.T) {
+	hookErr := errors.New("hook failed")
+
+	t.Run("before init", func(t *testing.T) {
+		launcher := NewLauncher()
+		events := &eventLog{}
+		require.NoError(t, launcher.AddNamedInitializer("config", recordingInit("config", events, 0)))
+		launcher.OnBeforeInit(recordingHook("before-init 1", events, hookErr), recordingHook("before-init 2", events, nil))
+		launcher.OnStopped(recordingHook("stopped", events, nil))
+
+		err := launcher.Run(context.Background())
+		assert.ErrorIs(t, err, hookErr)
+		assert.Equal(t, []string{"before-init 1"}, events.list())
+	})
+
+	t.Run("after init", func(t *testing.T) {
+		launcher := NewLauncher()
+		events := &eventLog{}
+		svc := newMockService("api")
+		require.NoError(t, launcher.AddNamedService("api", svc))
+		launcher.OnAfterInit(recordingHook("after-init", events, hookErr))
+		launcher.OnStopped(recordingHook("stopped", events, nil))
+
+		err := launcher.Run(context.Background())
+		assert.ErrorIs(t, err, hookErr)
+		assert.Equal(t, []string{"after-init", "stopped"}, events.list())
+		assert.Equal(t, int32(0), svc.startCalled)
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+		launcher := NewLauncher()
+		events := &eventLog{}
+		require.NoError(t, launcher.AddNamedInitializer("config", funcInitializer(func(ctx context.Context) error {
+			return hookErr
+		})))
+		launcher.OnAfterInit(recordingHook("after-init", events, nil))
+		launcher.OnStopped(recordingHook("stopped", events, nil))
+
+		err := launcher.Run(context.Background())
+		assert.ErrorIs(t, err, hookErr)
+		assert.Equal(t, []string{"stopped"}, events.list())
+	})
+}
+
+// TestRun_StartedHookError tests a failing OnStarted hook.
+// This verifies the failure stops all services like a service error.
+func TestRun_StartedHookError(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+	hookErr := errors.New("registration failed")
+
+	require.NoError(t, launcher.AddNamedService("api", newReadyService("api", events, 0)))
+	launcher.OnStarted(recordingHook("started 1", events, hookErr), recordingHook("started 2", events, nil))
+	launcher.OnStopped(recordingHook("stopped", events, nil))
+
+	done := make(chan error, 1)
+	go func() { done <- launcher.Run(context.Background()) }()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, hookErr)
+		assert.Contains(t, err.Error(), "started hook 0 failed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after OnStarted hook failed")
+	}
+	assert.Equal(t, []string{"start api", "ready api", "started 1", "stop api", "stopped"}, events.list())
+}
+
+// TestRun_ShutdownHookErrors tests failing OnBeforeStop and OnStopped hooks.
+// This verifies every hook still runs and all errors are returned from Run.
+func TestRun_ShutdownHookErrors(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+	ctx, cancel := context.WithCancel(context.Background())
+	errDeregister := errors.New("deregister failed")
+	errFlush := errors.New("flush failed")
+
+	svc := newMockService("api")
+	svc.blockStart = true
+	require.NoError(t, launcher.AddNamedService("api", svc))
+
+	launcher.OnBeforeStop(recordingHook("before-stop 1", events, errDeregister), recordingHook("before-stop 2", events, nil))
+	launcher.OnStopped(recordingHook("stopped 1", events, errFlush), func(ctx context.Context) error {
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline, "OnStopped hooks should be bounded by the shutdown timeout")
+		assert.NoError(t, ctx.Err(), "OnStopped hooks should not inherit Run's cancellation")
+		events.add("stopped 2")
+		return nil
+	})
+	launcher.OnStarted(func(ctx context.Context) error {
+		cancel()
+		return nil
+	})
+
+	err := launcher.Run(ctx)
+	assert.ErrorIs(t, err, errDeregister)
+	assert.ErrorIs(t, err, errFlush)
+	assert.Equal(t, []string{"before-stop 1", "before-stop 2", "stopped 1", "stopped 2"}, events.list())
+	assert.Equal(t, int32(1), svc.stopCalled)
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// InitializerOption configures an initializer registered with
+// Launcher.AddNamedInitializer.
+type InitializerOption func(*managedInitializer)
+
+// InitAfter declares that an initializer must not run until the named
+// initializers have completed successfully. Initializers without
+// dependencies on each other run concurrently.
+//
+// Parameters:
+//   - names: Names of the initializers this initializer depends on
+//
+// Returns:
+//   - InitializerOption: Option for AddNamedInitializer
+//
+// Example:
+//
+//	launcher.AddNamedInitializer("database", dbInit)
+//	launcher.AddNamedInitializer("migrations", migrator, service.InitAfter("database"))
+func InitAfter(names ...string) InitializerOption {
+	return func(m *managedInitializer) {
+		m.dependsOn = append(m.dependsOn, names...)
+	}
+}
+
+// InitTimeout sets the deadline for a single initializer, overriding the
+// launcher default set with SetInitTimeout. The deadline starts when the
+// initializer starts, not when the initialization phase starts.
+//
+// Parameters:
+//   - timeout: Maximum duration of the initializer's Init call
+//
+// Returns:
+//   - InitializerOption: Option for AddNamedInitializer
+//
+// Example:
+//
+//	launcher.AddNamedInitializer("remote-config", loader, service.InitTimeout(5*time.Second))
+func InitTimeout(timeout time.Duration) InitializerOption {
+	return func(m *managedInitializer) {
+		m.timeout = timeout
+	}
+}
+
+// managedInitializer is a registered initializer with its name,
+// dependencies and deadline.
+type managedInitializer struct {
+	// name identifies the initializer in logs, errors, metrics and InitAfter
+	name string
+
+	// init is the wrapped initializer
+	init Initializer
+
+	// dependsOn lists the names of initializers that must complete first
+	dependsOn []string
+
+	// timeout bounds Init; zero uses the launcher default
+	timeout time.Duration
+}
+
+// AddNamedInitializer registers an initializer under a unique name with
+// options such as InitAfter and InitTimeout. Dependency cycles are rejected
+// here; dependencies that are never registered are reported by Init.
+//
+// Parameters:
+//   - name: Unique initializer name used in logs, errors, metrics and InitAfter
+//   - init: Initializer to run
+//   - opts: Initializer options
+//
+// Returns:
+//   - error: Returns error if the name is empty or taken, or the
+//     initializer's dependencies would form a cycle
+//
+// Example:
+//
+//	launcher.AddNamedInitializer("database", dbInit, service.InitTimeout(10*time.Second))
+//	launcher.AddNamedInitializer("cache", cacheInit)
+//	launcher.AddNamedInitializer("warmup", warmer, service.InitAfter("database", "cache"))
+func (l *Launcher) AddNamedInitializer(name string, init Initializer, opts ...InitializerOption) error {
+	if name == "" {
+		return fmt.Errorf("initializer name cannot be empty")
+	}
+	if init == nil {
+		return fmt.Errorf("initializer %s is nil", name)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.findInitializer(name) != nil {
+		return fmt.Errorf("initializer %s already registered", name)
+	}
+
+	m := &managedInitializer{name: name, init: init}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	err := dependencyCycle(name, func(dep string) []string {
+		if dep == name {
+			return m.dependsOn
+		}
+		if existing := l.findInitializer(dep); existing != nil {
+			return existing.dependsOn
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	l.initCount++
+	l.initializers = append(l.initializers, m)
+	return nil
+}
+
+// RemoveInitializer unregisters the named initializer so that a later Init
+// does not run it. It does not affect an Init already in progress. An
+// initializer that other initializers depend on cannot be removed.
+//
+// Parameters:
+//   - name: Name of the initializer to remove
+//
+// Returns:
+//   - error: Returns error if the initializer is unknown or required by
+//     another initializer
+//
+// Thread Safety: This method is safe for concurrent use.
+func (l *Launcher) RemoveInitializer(name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	m := l.findInitializer(name)
+	if m == nil {
+		return fmt.Errorf("initializer %s not registered", name)
+	}
+	for _, other := range l.initializers {
+		if slices.Contains(other.dependsOn, name) {
+			return fmt.Errorf("initializer %s is required by initializer %s", name, other.name)
+		}
+	}
+
+	l.initializers = slices.DeleteFunc(l.initializers, func(i *managedInitializer) bool { return i == m })
+	if l.lastInit == name {
+		l.lastInit = ""
+	}
+	return nil
+}
+
+// SetInitTimeout configures the default deadline of each initializer.
+// Every initializer gets its own deadline, starting when it starts.
+// Initializers registered with InitTimeout use their own value instead.
+//
+// Parameters:
+//   - timeout: Maximum duration of each Init call; zero means no deadline
+//
+// Default: no deadline
+func (l *Launcher) SetInitTimeout(timeout time.Duration) {
+	l.initTimeout = timeout
+}
+
+// findInitializer returns the registered initializer with the given name, or nil.
+func (l *Launcher) findInitializer(name string) *managedInitializer {
+	for _, m := range l.initializers {
+		if m.name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Init runs all registered initializers. Each initializer starts once the
+// initializers it depends on have completed, so independent initializers
+// run concurrently; initializers added with AddInitializer still run one
+// after another in registration order. If any initializer fails, the
+// others are canceled, initializers that have not started are skipped and
+// the error is returned.
+//
+// Parameters:
+//   - ctx: Context for timeout control and cancellation
+//
+// Returns:
+//   - error: First initialization error encountered, or nil if all succeed
+//
+// Example:
+//
+//	if err := launcher.Init(ctx); err != nil {
+//	    log.Fatal("Initialization failed", log.Field{Key: "error", Value: err})
+//	}
+func (l *Launcher) Init(ctx context.Context) error {
+	l.mu.RLock()
+	inits := append([]*managedInitializer(nil), l.initializers...)
+	l.mu.RUnlock()
+
+	l.logger.Info("Starting initialization phase",
+		log.Field{Key: "initializer_count", Value: len(inits)})
+	start := time.Now()
+
+	completed := make(map[string]chan struct{}, len(inits))
+	for _, m := range inits {
+		completed[m.name] = make(chan struct{})
+	}
+	for _, m := range inits {
+		for _, dep := range m.dependsOn {
+			if _, ok := completed[dep]; !ok {
+				return fmt.Errorf("initializer %s depends on unknown initializer %s", m.name, dep)
+			}
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, m := range inits {
+		g.Go(func() error {
+			for _, dep := range m.dependsOn {
+				select {
+				case <-completed[dep]:
+				case <-gctx.Done():
+					return nil
+				}
+			}
+			if gctx.Err() != nil {
+				return nil
+			}
+
+			if err := l.runInitializer(gctx, m); err != nil {
+				return err
+			}
+			close(completed[m.name])
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("initialization canceled: %w", err)
+	}
+
+	l.logger.Info("Initialization phase completed successfully",
+		log.Field{Key: "duration", Value: time.Since(start)})
+	return nil
+}
+
+// runInitializer runs a single initializer under its deadline and records
+// its duration. An initializer that ignores its context is abandoned once
+// the deadline passes.
+func (l *Launcher) runInitializer(ctx context.Context, m *managedInitializer) error {
+	timeout := m.timeout
+	if timeout <= 0 {
+		timeout = l.initTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	l.logger.Debug("Running initializer",
+		log.Field{Key: "name", Value: m.name},
+		log.Field{Key: "type", Value: fmt.Sprintf("%T", m.init)})
+	start := time.Now()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- m.init.Init(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	duration := time.Since(start)
+	l.metrics.initDuration.WithLabelValues(m.name).Set(duration.Seconds())
+
+	if err != nil {
+		l.logger.Error("Initializer failed",
+			log.Field{Key: "name", Value: m.name},
+			log.Field{Key: "duration", Value: duration},
+			log.Field{Key: "error", Value: err})
+		return fmt.Errorf("initializer %s failed: %w", m.name, err)
+	}
+
+	l.logger.Info("Initializer completed",
+		log.Field{Key: "name", Value: m.name},
+		log.Field{Key: "duration", Value: duration})
+	return nil
+}
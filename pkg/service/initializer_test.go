@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// funcInitializer adapts a function to the Initializer interface.
+type funcInitializer func(ctx context.Context) error
+
+func (f funcInitializer) Init(ctx context.Context) error {
+	return f(ctx)
+}
+
+// recordingInit logs its start and end and sleeps for delay in between.
+func recordingInit(name string, log *eventLog, delay time.Duration) Initializer {
+	return funcInitializer(func(ctx context.Context) error {
+		log.add("start " + name)
+		time.Sleep(delay)
+		log.add("done " + name)
+		return nil
+	})
+}
+
+// TestAddNamedInitializer_Validation tests initializer registration checks.
+// This verifies empty names, duplicates and dependency cycles are rejected.
+func TestAddNamedInitializer_Validation(t *testing.T) {
+	launcher := NewLauncher()
+	noop := &mockInitializer{}
+
+	assert.Error(t, launcher.AddNamedInitializer("", noop))
+	assert.Error(t, launcher.AddNamedInitializer("nil", nil))
+
+	require.NoError(t, launcher.AddNamedInitializer("a", noop, InitAfter("b")))
+	require.NoError(t, launcher.AddNamedInitializer("b", noop))
+
+	err := launcher.AddNamedInitializer("a", noop)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+
+	err = launcher.AddNamedInitializer("c", noop, InitAfter("a"), InitAfter("c"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle: c -> c")
+}
+
+// TestRemoveInitializer tests unregistering initializers.
+// This verifies removed initializers do not run and required ones cannot be removed.
+func TestRemoveInitializer(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+
+	require.NoError(t, launcher.AddNamedInitializer("database", recordingInit("database", events, 0)))
+	require.NoError(t, launcher.AddNamedInitializer("schema", recordingInit("schema", events, 0), InitAfter("database")))
+	require.NoError(t, launcher.AddNamedInitializer("cache", recordingInit("cache", events, 0)))
+
+	err := launcher.RemoveInitializer("database")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required by initializer schema")
+	assert.Error(t, launcher.RemoveInitializer("unknown"))
+
+	require.NoError(t, launcher.RemoveInitializer("schema"))
+	require.NoError(t, launcher.RemoveInitializer("cache"))
+	require.NoError(t, launcher.AddNamedInitializer("cache", recordingInit("cache", events, 0)), "A removed name can be reused")
+
+	require.NoError(t, launcher.Init(context.Background()))
+	assert.ElementsMatch(t, []string{"start database", "done database", "start cache", "done cache"}, events.list())
+}
+
+// TestAddInitializer_NamesAfterRemove tests naming after RemoveInitializer.
+// This verifies generated names stay unique and the sequential chain keeps its order.
+func TestAddInitializer_NamesAfterRemove(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+
+	launcher.AddInitializer(recordingInit("first", events, 0))
+	require.NoError(t, launcher.AddNamedInitializer("extra", recordingInit("extra", events, 0)))
+	launcher.AddInitializer(recordingInit("second", events, 0))
+	require.NoError(t, launcher.RemoveInitializer("extra"))
+	launcher.AddInitializer(recordingInit("third", events, 0))
+
+	names := make(map[string]bool)
+	for _, m := range launcher.initializers {
+		assert.False(t, names[m.name], "Duplicate initializer name %s", m.name)
+		names[m.name] = true
+	}
+	assert.Len(t, names, 3)
+
+	require.NoError(t, launcher.Init(context.Background()))
+	assert.Equal(t, []string{"start first", "done first", "start second", "done second", "start third", "done third"}, events.list())
+}
+
+// TestInit_Concurrent tests that independent initializers run concurrently.
+// This verifies dependents wait for their dependencies and durations are recorded.
+func TestInit_Concurrent(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+
+	require.NoError(t, launcher.AddNamedInitializer("warmup",
+		recordingInit("warmup", events, 0), InitAfter("database", "cache")))
+	require.NoError(t, launcher.AddNamedInitializer("database", recordingInit("database", events, 50*time.Millisecond)))
+	require.NoError(t, launcher.AddNamedInitializer("cache", recordingInit("cache", events, 50*time.Millisecond)))
+
+	start := time.Now()
+	require.NoError(t, launcher.Init(context.Background()))
+	assert.Less(t, time.Since(start), 100*time.Millisecond, "Independent initializers should overlap")
+
+	list := events.list()
+	require.Len(t, list, 6)
+	assert.Equal(t, []string{"start warmup", "done warmup"}, list[4:])
+
+	assert.GreaterOrEqual(t, testutil.ToFloat64(launcher.metrics.initDuration.WithLabelValues("database")), 0.05)
+	assert.Equal(t, 3, testutil.CollectAndCount(launcher.metrics.initDuration))
+}
+
+// TestInit_LegacyInitializersStaySequential tests mixing both registration styles.
+// This verifies AddInitializer keeps registration order while named initializers run alongside.
+func TestInit_LegacyInitializersStaySequential(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+
+	launcher.AddInitializer(recordingInit("first", events, 20*time.Millisecond))
+	require.NoError(t, launcher.AddNamedInitializer("named", recordingInit("named", events, 0)))
+	launcher.AddInitializer(recordingInit("second", events, 0))
+
+	require.NoError(t, launcher.Init(context.Background()))
+
+	list := events.list()
+	assert.Less(t, indexOf(list, "done first"), indexOf(list, "start second"))
+	assert.Less(t, indexOf(list, "done named"), indexOf(list, "done first"))
+}
+
+// TestInit_Timeout tests per-initializer deadlines.
+// This verifies each initializer's deadline starts when it runs and hung initializers are abandoned.
+func TestInit_Timeout(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.SetInitTimeout(40 * time.Millisecond)
+	events := &eventLog{}
+
+	// Each takes 30ms: the chain exceeds the default deadline, but no single step does
+	launcher.AddInitializer(recordingInit("first", events, 30*time.Millisecond))
+	launcher.AddInitializer(recordingInit("second", events, 30*time.Millisecond))
+	require.NoError(t, launcher.Init(context.Background()))
+
+	hung := make(chan struct{})
+	defer close(hung)
+	require.NoError(t, launcher.AddNamedInitializer("remote-config", funcInitializer(func(ctx context.Context) error {
+		<-hung
+		return nil
+	}), InitTimeout(10*time.Millisecond)))
+
+	start := time.Now()
+	err := launcher.Init(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "initializer remote-config failed")
+	assert.Less(t, time.Since(start), 40*time.Millisecond)
+}
+
+// TestInit_FailureSkipsDependents tests error propagation between initializers.
+// This verifies a failure cancels running initializers and skips their dependents.
+func TestInit_FailureSkipsDependents(t *testing.T) {
+	launcher := NewLauncher()
+	skipped := &mockInitializer{}
+	canceled := make(chan error, 1)
+
+	require.NoError(t, launcher.AddNamedInitializer("database", &mockInitializer{initError: errors.New("dial failed")}))
+	require.NoError(t, launcher.AddNamedInitializer("migrations", skipped, InitAfter("database")))
+	require.NoError(t, launcher.AddNamedInitializer("cache", funcInitializer(func(ctx context.Context) error {
+		<-ctx.Done()
+		canceled <- ctx.Err()
+		return ctx.Err()
+	})))
+
+	err := launcher.Init(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "initializer database failed: dial failed")
+	// cache is either canceled mid-run or skipped, depending on scheduling
+	select {
+	case err := <-canceled:
+		assert.ErrorIs(t, err, context.Canceled)
+	default:
+	}
+	assert.Zero(t, skipped.initCalled)
+}
+
+// TestInit_UnknownDependency tests dependencies on unregistered initializers.
+// This verifies Init fails before running anything.
+func TestInit_UnknownDependency(t *testing.T) {
+	launcher := NewLauncher()
+	init := &mockInitializer{}
+	require.NoError(t, launcher.AddNamedInitializer("migrations", init, InitAfter("database")))
+
+	err := launcher.Init(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "depends on unknown initializer database")
+	assert.Zero(t, init.initCalled)
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
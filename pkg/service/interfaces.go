@@ -68,8 +68,19 @@ type Service interface {
 	Stop(ctx context.Context) error
 }
 
+// ReadyNotifier is an optional interface for services whose Start blocks
+// before they can serve, such as servers that must bind a port. The launcher
+// starts services that depend on a ReadyNotifier only after its Ready
+// channel is closed. Services that do not implement it are considered ready
+// as soon as Start is called.
+type ReadyNotifier interface {
+	// Ready returns a channel that is closed once the service is ready to
+	// serve. It must return the same channel on every call during a run.
+	Ready() <-chan struct{}
+}
+
 // Initializer defines the interface for one-time initialization tasks.
-// Initializers run sequentially before services start, setting up
+// Initializers run before services start, setting up
 // dependencies like database connections, caches, and external clients.
 //
 // Use cases:
@@ -79,8 +90,10 @@ type Service interface {
 //   - Configuration validation
 //   - Feature flag loading
 //
-// Initializers are executed in registration order, allowing controlled
-// dependency setup sequences.
+// Initializers added with Launcher.AddInitializer are executed in
+// registration order. Initializers added with Launcher.AddNamedInitializer
+// run concurrently unless ordered with InitAfter, and may be given a
+// deadline with InitTimeout.
 type Initializer interface {
 	// Init performs one-time initialization logic.
 	// Called by Launcher during application startup, before any services start.
@@ -36,20 +36,20 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
-	"os/signal"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
-	"golang.org/x/sync/errgroup"
-
 	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
 )
 
 // Launcher orchestrates the application lifecycle by managing initializers and services.
 // It provides a standardized pattern for:
-//   - Sequential initialization of dependencies
+//   - Initialization of dependencies, concurrent where they allow
 //   - Concurrent service startup with error handling
 //   - Graceful shutdown on termination signals
 //   - Coordinated resource cleanup
@@ -60,12 +60,68 @@ import (
 //  3. Register services: launcher.AddService(httpServer, grpcServer)
 //  4. Run: launcher.Run(context.Background())
 //
+// Services registered with AddNamedService may declare dependencies with
+// DependsOn. A service starts only once its dependencies are ready, and
+// services stop in reverse dependency order. Services may also be added
+// and removed while the launcher is running; Status reports the state of
+// each one.
+//
 // The launcher handles SIGINT and SIGTERM signals for graceful shutdown.
 type Launcher struct {
-	initializers    []Initializer
-	services        []Service
+	initializers    []*managedInitializer
+	services        []*managedService
 	logger          log.Logger
 	shutdownTimeout time.Duration
+
+	// shutdownSignals trigger a graceful shutdown from Run
+	shutdownSignals []os.Signal
+
+	// reloadSignals trigger reloadFunc from Run
+	reloadSignals []os.Signal
+
+	// reloadFunc reloads configuration; reload signals are ignored if nil
+	reloadFunc ReloadFunc
+
+	// drainPeriod is how long Run waits after a shutdown signal before
+	// stopping services
+	drainPeriod time.Duration
+
+	// draining is set once Run has received a shutdown signal
+	draining atomic.Bool
+
+	// stopTimeout is the default stop budget of each service
+	stopTimeout time.Duration
+
+	// parallelStop stops independent services concurrently
+	parallelStop bool
+
+	// lastShutdown reports the latest shutdown
+	lastShutdown *ShutdownReport
+
+	// initTimeout is the default deadline of each initializer
+	initTimeout time.Duration
+
+	// hooks are the registered lifecycle hooks
+	hooks lifecycleHooks
+
+	// run tracks the services of the run in progress; nil while not running
+	run *runState
+
+	// lastInit names the latest initializer added with AddInitializer;
+	// each one depends on the previous one so they keep running sequentially
+	lastInit string
+
+	// initCount counts initializers ever registered; it numbers those added
+	// with AddInitializer and never decreases, so names stay unique after
+	// RemoveInitializer
+	initCount int
+
+	// mu protects initializers, services, their per-run readiness signals,
+	// hooks, run and lastShutdown
+	mu sync.RWMutex
+
+	// metrics tracks initializer durations and service failures and restarts
+	metrics *launcherMetrics
 }
 
 // NewLauncher creates a new service launcher with default configuration.
@@ -81,15 +137,21 @@ type Launcher struct {
 //	launcher.Run(ctx)
 func NewLauncher() *Launcher {
 	return &Launcher{
-		initializers:    make([]Initializer, 0),
-		services:        make([]Service, 0),
+		initializers:    make([]*managedInitializer, 0),
+		services:        make([]*managedService, 0),
 		logger:          log.Default(),
 		shutdownTimeout: 30 * time.Second,
+		shutdownSignals: defaultShutdownSignals(),
+		reloadSignals:   []os.Signal{syscall.SIGHUP},
+		metrics:         newLauncherMetrics(),
 	}
 }
 
 // AddInitializer registers one or more initializers to run before services start.
-// Initializers execute sequentially in registration order.
+// Initializers added this way execute sequentially in registration order,
+// each named after its type and registration index (e.g., "*db.TiDBInitializer#0").
+// Use AddNamedInitializer to run initializers concurrently, declare
+// dependencies or set a deadline.
 //
 // Parameters:
 //   - inits: One or more Initializer instances
@@ -98,11 +160,32 @@ func NewLauncher() *Launcher {
 //
 //	launcher.AddInitializer(dbInit, cacheInit, clientInit)
 func (l *Launcher) AddInitializer(inits ...Initializer) {
-	l.initializers = append(l.initializers, inits...)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, init := range inits {
+		name := fmt.Sprintf("%T#%d", init, l.initCount)
+		for l.findInitializer(name) != nil {
+			l.initCount++
+			name = fmt.Sprintf("%T#%d", init, l.initCount)
+		}
+		l.initCount++
+
+		m := &managedInitializer{name: name, init: init}
+		if l.lastInit != "" {
+			m.dependsOn = []string{l.lastInit}
+		}
+		l.lastInit = m.name
+		l.initializers = append(l.initializers, m)
+	}
 }
 
 // AddService registers one or more services to run concurrently.
-// Services start after all initializers complete successfully.
+// Services start after all initializers complete successfully; services
+// added while the launcher is running are started at once.
+// Each service is named after its type and registration index
+// (e.g., "*server.HTTPServer#0"); use AddNamedService to choose the name
+// or declare dependencies.
 //
 // Parameters:
 //   - svcs: One or more Service instances
@@ -111,7 +194,78 @@ func (l *Launcher) AddInitializer(inits ...Initializer) {
 //
 //	launcher.AddService(httpServer, grpcServer, metricsServer)
 func (l *Launcher) AddService(svcs ...Service) {
-	l.services = append(l.services, svcs...)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, svc := range svcs {
+		name := fmt.Sprintf("%T#%d", svc, len(l.services))
+		if err := l.register(newManagedService(name, svc, nil)); err != nil {
+			l.logger.Warn("Service not added", log.Field{Key: "error", Value: err})
+		}
+	}
+}
+
+// AddNamedService registers a service under a unique name with options
+// such as DependsOn. Dependency cycles are rejected here; dependencies that
+// are never registered are reported when the launcher runs.
+//
+// While the launcher is running, the service is started at once, after its
+// dependencies are ready, which must then already be registered. A service
+// added this way that fails is treated like any other failed service.
+// Use RemoveService to stop and unregister it again.
+//
+// Parameters:
+//   - name: Unique service name used in logs, errors and DependsOn
+//   - svc: Service to run
+//   - opts: Service options
+//
+// Returns:
+//   - error: Returns error if the name is empty or taken, the service's
+//     dependencies would form a cycle, or the launcher is shutting down
+//
+// Example:
+//
+//	launcher.AddNamedService("cache-warmer", warmer)
+//	if err := launcher.AddNamedService("http", httpServer, service.DependsOn("cache-warmer")); err != nil {
+//	    log.Fatal("Invalid service graph", log.Field{Key: "error", Value: err})
+//	}
+func (l *Launcher) AddNamedService(name string, svc Service, opts ...ServiceOption) error {
+	if name == "" {
+		return fmt.Errorf("service name cannot be empty")
+	}
+	if svc == nil {
+		return fmt.Errorf("service %s is nil", name)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.findService(name) != nil {
+		return fmt.Errorf("service %s already registered", name)
+	}
+
+	m := newManagedService(name, svc, opts)
+	if err := l.checkCycle(m); err != nil {
+		return err
+	}
+
+	return l.register(m)
+}
+
+// Services returns the registered services in registration order.
+// The returned slice is a copy and may be modified by the caller.
+//
+// Returns:
+//   - []Service: Registered services
+func (l *Launcher) Services() []Service {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	svcs := make([]Service, len(l.services))
+	for i, m := range l.services {
+		svcs[i] = m.svc
+	}
+	return svcs
 }
 
 // SetLogger configures a custom logger for launcher operations.
@@ -124,7 +278,8 @@ func (l *Launcher) SetLogger(logger log.Logger) {
 }
 
 // SetShutdownTimeout configures the maximum time to wait for graceful shutdown.
-// If services don't stop within this timeout, shutdown proceeds forcefully.
+// It caps the stop budgets of all services together; services still running
+// when it expires are abandoned and reported in the ShutdownReport.
 //
 // Parameters:
 //   - timeout: Maximum duration for shutdown completion
@@ -134,44 +289,14 @@ func (l *Launcher) SetShutdownTimeout(timeout time.Duration) {
 	l.shutdownTimeout = timeout
 }
 
-// Init runs all registered initializers sequentially.
-// If any initializer fails, subsequent initializers are skipped and
-// the error is returned immediately.
-//
-// Parameters:
-//   - ctx: Context for timeout control and cancellation
-//
-// Returns:
-//   - error: First initialization error encountered, or nil if all succeed
-//
-// Example:
-//
-//	if err := launcher.Init(ctx); err != nil {
-//	    log.Fatal("Initialization failed", log.Field{Key: "error", Value: err})
-//	}
-func (l *Launcher) Init(ctx context.Context) error {
-	l.logger.Info("Starting initialization phase",
-		log.Field{Key: "initializer_count", Value: len(l.initializers)})
-
-	for i, init := range l.initializers {
-		l.logger.Debug("Running initializer",
-			log.Field{Key: "index", Value: i},
-			log.Field{Key: "type", Value: fmt.Sprintf("%T", init)})
-
-		if err := init.Init(ctx); err != nil {
-			return fmt.Errorf("initializer %d (%T) failed: %w", i, init, err)
-		}
-	}
-
-	l.logger.Info("Initialization phase completed successfully")
-	return nil
-}
-
 // Run executes the complete application lifecycle:
-//  1. Runs all initializers sequentially
-//  2. Starts all services concurrently
+//  1. Runs all initializers, respecting their dependencies, between the
+//     OnBeforeInit and OnAfterInit hooks
+//  2. Starts all services concurrently and runs the OnStarted hooks once
+//     they are ready
 //  3. Waits for termination signal or service error
-//  4. Performs graceful shutdown
+//  4. Runs the OnBeforeStop hooks and performs graceful shutdown
+//  5. Runs the OnStopped hooks
 //
 // This method blocks until shutdown completes or an error occurs.
 //
@@ -179,11 +304,18 @@ func (l *Launcher) Init(ctx context.Context) error {
 //   - ctx: Root context for the application
 //
 // Returns:
-//   - error: Returns error if initialization, service startup, or shutdown fails
+//   - error: Returns error if initialization, service startup, or shutdown fails.
+//     A failed service is reported as a *ServiceError, joined with a
+//     *StopError for every service that then failed to stop. After a
+//     signal or ctx cancellation, only StopErrors are returned. Hook
+//     errors are joined with the other errors.
 //
-// Signal handling:
+// Signal handling (see SetShutdownSignals, SetDrainPeriod and SetReloadFunc):
 //   - SIGINT (Ctrl+C): Triggers graceful shutdown
-//   - SIGTERM: Triggers graceful shutdown (from orchestrators)
+//   - SIGTERM: Triggers graceful shutdown (from orchestrators). The
+//     ReadinessCheck fails immediately and services are stopped after the
+//     drain period
+//   - SIGHUP: Calls the reload function, if one is set
 //
 // Example:
 //
@@ -191,15 +323,33 @@ func (l *Launcher) Init(ctx context.Context) error {
 //	    log.Error("Application failed", log.Field{Key: "error", Value: err})
 //	    os.Exit(1)
 //	}
-func (l *Launcher) Run(ctx context.Context) error {
-	// Phase 1: Run initializers
+func (l *Launcher) Run(ctx context.Context) (err error) {
+	// Phase 1: Run initializers between the init hooks
+	beforeInit := l.hooksFor(func(h *lifecycleHooks) []Hook { return h.beforeInit })
+	if err := l.runHooks(ctx, "before-init", beforeInit, true); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, l.runStoppedHooks(ctx))
+	}()
+
 	if err := l.Init(ctx); err != nil {
 		return fmt.Errorf("initialization failed: %w", err)
 	}
 
-	// Phase 2: Setup signal handling for graceful shutdown
-	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	afterInit := l.hooksFor(func(h *lifecycleHooks) []Hook { return h.afterInit })
+	if err := l.runHooks(ctx, "after-init", afterInit, true); err != nil {
+		return err
+	}
+
+	// Phase 2: Setup signal handling for draining, graceful shutdown and reload
+	l.draining.Store(false)
+	sigCh, stopNotify := l.notifySignals()
+	defer stopNotify()
+
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	go l.handleSignals(ctx, sigCh, cancel)
 
 	// Phase 3: Start all services concurrently
 	if err := l.startServices(ctx); err != nil {
@@ -209,72 +359,129 @@ func (l *Launcher) Run(ctx context.Context) error {
 	return nil
 }
 
-// startServices launches all registered services concurrently using errgroup.
-// If any service fails to start or crashes, all other services are stopped.
+// startServices launches all registered services concurrently.
+// A service is started once all of its dependencies are ready. Services
+// registered while it runs are started at once, and removed services are
+// stopped individually.
+// When ctx is canceled or any service fails, all services are stopped in
+// reverse dependency order.
 func (l *Launcher) startServices(ctx context.Context) error {
+	l.mu.Lock()
+	if err := l.checkDependencies(); err != nil {
+		l.mu.Unlock()
+		return err
+	}
+
 	l.logger.Info("Starting services",
 		log.Field{Key: "service_count", Value: len(l.services)})
 
-	g, ctx := errgroup.WithContext(ctx)
+	run := &runState{
+		ctx:       ctx,
+		failed:    make(chan error, 1),
+		allExited: make(chan struct{}),
+	}
+	l.run = run
 
-	// Start each service in its own goroutine
-	for i, svc := range l.services {
-		// Capture loop variables
-		index := i
-		service := svc
+	// Start each service in its own goroutine, gated on its dependencies
+	for _, m := range l.services {
+		m.reset(ctx)
+	}
+	for i, m := range l.services {
+		l.launch(run, m, i)
+	}
+	if run.active == 0 {
+		close(run.allExited)
+	}
+	l.mu.Unlock()
 
-		g.Go(func() error {
-			l.logger.Info("Starting service",
-				log.Field{Key: "index", Value: index},
-				log.Field{Key: "type", Value: fmt.Sprintf("%T", service)})
+	defer func() {
+		l.mu.Lock()
+		l.run = nil
+		l.mu.Unlock()
+	}()
 
-			if err := service.Start(ctx); err != nil {
-				return fmt.Errorf("service %d (%T) failed: %w", index, service, err)
-			}
-			return nil
-		})
-	}
+	started := make(chan error, 1)
+	go l.awaitStarted(ctx, run.allExited, started)
 
-	// Wait for all services to complete or context cancellation
-	if err := g.Wait(); err != nil {
-		// Context cancellation is expected during shutdown
-		if ctx.Err() != nil {
-			l.logger.Info("Services stopped due to context cancellation")
+	// Wait for all services to complete, a service or OnStarted hook error,
+	// or context cancellation
+	for {
+		select {
+		case <-run.allExited:
+			select {
+			case err := <-run.failed:
+				return l.failed(err)
+			default:
+				return nil
+			}
+		case err := <-run.failed:
+			return l.failed(err)
+		case err := <-started:
+			if err != nil {
+				return l.failed(err)
+			}
+		case <-ctx.Done():
+			// Context cancellation is expected during shutdown
+			l.logger.Info("Services stopping due to context cancellation")
 			return l.shutdown()
 		}
-		// Actual service error - perform emergency shutdown
-		l.logger.Error("Service error occurred", log.Field{Key: "error", Value: err})
-		return l.shutdown()
 	}
+}
 
-	return nil
+// failed performs an emergency shutdown after a service error and returns
+// the error joined with any errors from stopping the services.
+func (l *Launcher) failed(err error) error {
+	l.logger.Error("Service error occurred", log.Field{Key: "error", Value: err})
+	return errors.Join(err, l.shutdown())
 }
 
-// shutdown performs graceful shutdown of all services with timeout.
-// Services are stopped in reverse registration order for proper dependency cleanup.
-func (l *Launcher) shutdown() error {
-	l.logger.Info("Initiating graceful shutdown",
-		log.Field{Key: "timeout", Value: l.shutdownTimeout})
+// awaitDependencies blocks until every dependency of m is ready or m is
+// stopped. It fails if a dependency exits without becoming ready.
+func (l *Launcher) awaitDependencies(m *managedService) error {
+	for _, name := range m.dependsOn {
+		l.mu.RLock()
+		dep := l.findService(name)
+		l.mu.RUnlock()
+		if dep == nil {
+			return fmt.Errorf("service %s: dependency %s was removed", m.name, name)
+		}
 
-	// Create context with timeout for shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), l.shutdownTimeout)
-	defer cancel()
+		if !dep.isReady() {
+			l.logger.Debug("Waiting for dependency",
+				log.Field{Key: "service", Value: m.name},
+				log.Field{Key: "dependency", Value: name})
+		}
 
-	// Stop services in reverse order
-	for i := len(l.services) - 1; i >= 0; i-- {
-		svc := l.services[i]
-		l.logger.Debug("Stopping service",
-			log.Field{Key: "index", Value: i},
-			log.Field{Key: "type", Value: fmt.Sprintf("%T", svc)})
-
-		if err := svc.Stop(ctx); err != nil {
-			l.logger.Error("Failed to stop service",
-				log.Field{Key: "index", Value: i},
-				log.Field{Key: "error", Value: err})
-			// Continue stopping other services despite error
+		select {
+		case <-dep.readyChan():
+		case <-dep.exited:
+			if !dep.isReady() {
+				return fmt.Errorf("service %s: dependency %s stopped before becoming ready", m.name, name)
+			}
+		case <-m.ctx.Done():
+			return nil
 		}
 	}
-
-	l.logger.Info("Graceful shutdown completed")
 	return nil
 }
+
+// watchReady marks m ready when its service reports ready during the
+// attempt that ends when done is closed. Services that do not implement
+// ReadyNotifier are considered ready once started.
+func (l *Launcher) watchReady(m *managedService, done <-chan struct{}) {
+	notifier, ok := m.svc.(ReadyNotifier)
+	if !ok {
+		m.markReady()
+		return
+	}
+
+	go func() {
+		select {
+		case <-notifier.Ready():
+			l.logger.Debug("Service ready", log.Field{Key: "name", Value: m.name})
+			m.markReady()
+		case <-done:
+		case <-m.ctx.Done():
+		}
+	}()
+}
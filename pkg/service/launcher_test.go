@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // mockInitializer is a test implementation of Initializer interface.
@@ -149,6 +150,22 @@ func TestAddService_Multiple(t *testing.T) {
 	assert.Len(t, launcher.services, 3)
 }
 
+// TestServices tests listing registered services.
+// This verifies registration order is kept and the returned slice is a copy.
+func TestServices(t *testing.T) {
+	launcher := NewLauncher()
+
+	svc1 := newMockService("service1")
+	svc2 := newMockService("service2")
+	launcher.AddService(svc1, svc2)
+
+	svcs := launcher.Services()
+	assert.Equal(t, []Service{svc1, svc2}, svcs)
+
+	svcs[0] = nil
+	assert.Equal(t, svc1, launcher.Services()[0])
+}
+
 // TestSetShutdownTimeout tests configuring shutdown timeout.
 // This is an isolated method test with no external dependencies.
 func TestSetShutdownTimeout(t *testing.T) {
@@ -250,9 +267,9 @@ func TestInit_Order(t *testing.T) {
 	)
 
 	// We can't easily override methods, so let's verify the order through delays
-	launcher.initializers[0] = &mockInitializer{delay: 10 * time.Millisecond}
-	launcher.initializers[1] = &mockInitializer{delay: 10 * time.Millisecond}
-	launcher.initializers[2] = &mockInitializer{delay: 10 * time.Millisecond}
+	launcher.initializers[0].init = &mockInitializer{delay: 10 * time.Millisecond}
+	launcher.initializers[1].init = &mockInitializer{delay: 10 * time.Millisecond}
+	launcher.initializers[2].init = &mockInitializer{delay: 10 * time.Millisecond}
 
 	start := time.Now()
 	ctx := context.Background()
@@ -309,21 +326,54 @@ func TestStartServices_Empty(t *testing.T) {
 }
 
 // TestStartServices_ServiceError tests error handling from services.
-// This verifies that service errors trigger shutdown.
+// This verifies that service errors trigger shutdown and are returned with the service name.
 func TestStartServices_ServiceError(t *testing.T) {
 	launcher := NewLauncher()
 
 	svc1 := newMockService("service1")
 	svc1.startError = errors.New("service startup failed")
+	svc2 := newMockService("service2")
+	svc2.blockStart = true
 
-	launcher.AddService(svc1)
+	require.NoError(t, launcher.AddNamedService("service1", svc1))
+	require.NoError(t, launcher.AddNamedService("service2", svc2))
 
 	ctx := context.Background()
 
 	err := launcher.startServices(ctx)
 
-	assert.NoError(t, err, "Error from service triggers shutdown, which returns nil")
+	var svcErr *ServiceError
+	require.True(t, errors.As(err, &svcErr), "Service error must be returned")
+	assert.Equal(t, "service1", svcErr.Name)
+	assert.EqualError(t, svcErr.Err, "service startup failed")
 	assert.Equal(t, int32(1), atomic.LoadInt32(&svc1.startCalled))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&svc2.stopCalled), "Other services must be stopped")
+}
+
+// TestRun_ServiceErrorWithStopErrors tests the error returned by Run.
+// This verifies the failure is joined with errors from stopping other services.
+func TestRun_ServiceErrorWithStopErrors(t *testing.T) {
+	launcher := NewLauncher()
+
+	failing := newMockService("failing")
+	failing.startDelay = 20 * time.Millisecond
+	failing.startError = errors.New("bind: address already in use")
+	stubborn := newMockService("stubborn")
+	stubborn.blockStart = true
+	stubborn.stopError = errors.New("connections still open")
+
+	require.NoError(t, launcher.AddNamedService("http", failing))
+	require.NoError(t, launcher.AddNamedService("worker", stubborn))
+
+	err := launcher.Run(context.Background())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "service http failed: bind: address already in use")
+	assert.Contains(t, err.Error(), "service worker failed to stop: connections still open")
+
+	var stopErr *StopError
+	require.True(t, errors.As(err, &stopErr))
+	assert.Equal(t, "worker", stopErr.Name)
 }
 
 // TestShutdown_Success tests successful graceful shutdown.
@@ -369,7 +419,7 @@ func TestShutdown_ReverseOrder(t *testing.T) {
 	launcher.AddService(svc1, svc2, svc3)
 
 	// Store services with custom Stop handlers
-	launcher.services[0] = &stopTrackerService{
+	launcher.services[0].svc = &stopTrackerService{
 		name: "service1",
 		onStop: func() {
 			stopOrderMutex.Lock()
@@ -377,7 +427,7 @@ func TestShutdown_ReverseOrder(t *testing.T) {
 			stopOrderMutex.Unlock()
 		},
 	}
-	launcher.services[1] = &stopTrackerService{
+	launcher.services[1].svc = &stopTrackerService{
 		name: "service2",
 		onStop: func() {
 			stopOrderMutex.Lock()
@@ -385,7 +435,7 @@ func TestShutdown_ReverseOrder(t *testing.T) {
 			stopOrderMutex.Unlock()
 		},
 	}
-	launcher.services[2] = &stopTrackerService{
+	launcher.services[2].svc = &stopTrackerService{
 		name: "service3",
 		onStop: func() {
 			stopOrderMutex.Lock()
@@ -433,8 +483,11 @@ func TestShutdown_WithErrors(t *testing.T) {
 
 	err := launcher.shutdown()
 
-	// Shutdown should complete despite error
-	assert.NoError(t, err, "Shutdown continues despite individual service errors")
+	// Shutdown should complete despite error, and report it
+	var stopErr *StopError
+	require.True(t, errors.As(err, &stopErr), "Stop errors must be returned")
+	assert.Equal(t, "*service.mockService#1", stopErr.Name)
+	assert.EqualError(t, stopErr.Err, "stop failed")
 
 	// All services should be attempted
 	assert.Equal(t, int32(1), atomic.LoadInt32(&svc1.stopCalled))
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// launcherMetrics holds the Prometheus metrics exported by a Launcher.
+// They are not registered anywhere by default; see Launcher.Collectors.
+type launcherMetrics struct {
+	// initDuration holds how long each initializer took in the latest Init
+	initDuration *prometheus.GaugeVec
+
+	// restarts counts restarts per service
+	restarts *prometheus.CounterVec
+
+	// failures counts errors returned by Start per service
+	failures *prometheus.CounterVec
+
+	// lastFailure holds the Unix time of the latest failure per service
+	lastFailure *prometheus.GaugeVec
+}
+
+// newLauncherMetrics creates the launcher metrics.
+func newLauncherMetrics() *launcherMetrics {
+	return &launcherMetrics{
+		initDuration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "eggybyte",
+			Subsystem: "service",
+			Name:      "initializer_duration_seconds",
+			Help:      "Duration of the latest run of each initializer.",
+		}, []string{"initializer"}),
+		restarts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "service",
+			Name:      "restarts_total",
+			Help:      "Number of times a service was restarted by its restart policy.",
+		}, []string{"service"}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "eggybyte",
+			Subsystem: "service",
+			Name:      "failures_total",
+			Help:      "Number of times a service's Start returned an error.",
+		}, []string{"service"}),
+		lastFailure: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "eggybyte",
+			Subsystem: "service",
+			Name:      "last_failure_timestamp_seconds",
+			Help:      "Unix time of the latest service failure.",
+		}, []string{"service"}),
+	}
+}
+
+// recordFailure records that the named service's Start returned an error.
+// The error message is left to logs and Status: as a label value it would
+// create a series per distinct message and could expose its text.
+func (m *launcherMetrics) recordFailure(name string) {
+	m.failures.WithLabelValues(name).Inc()
+	m.lastFailure.WithLabelValues(name).Set(float64(time.Now().Unix()))
+}
+
+// forget deletes the named service's series, e.g. once it is removed.
+func (m *launcherMetrics) forget(name string) {
+	labels := prometheus.Labels{"service": name}
+	m.restarts.DeletePartialMatch(labels)
+	m.failures.DeletePartialMatch(labels)
+	m.lastFailure.DeletePartialMatch(labels)
+}
+
+// collectors returns every launcher metric.
+func (m *launcherMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.initDuration, m.restarts, m.failures, m.lastFailure}
+}
+
+// Collectors returns the launcher's Prometheus collectors: initializer
+// durations, service restarts, failures, and the time of each service's
+// latest failure. Register them with a metrics registry to export them.
+//
+// Returns:
+//   - []prometheus.Collector: Collectors to register
+//
+// Example:
+//
+//	for _, c := range launcher.Collectors() {
+//	    metricsService.RegisterCollector(c)
+//	}
+func (l *Launcher) Collectors() []prometheus.Collector {
+	return l.metrics.collectors()
+}
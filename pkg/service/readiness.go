@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ReadinessCheck reports whether every service registered with a Launcher
+// is ready. It satisfies monitoring.HealthChecker, so adding it to a
+// HealthService makes /readyz fail until all services report ready.
+//
+// A service is ready once its ReadyNotifier channel is closed, or once
+// Start is called if it does not implement ReadyNotifier. Before the
+// launcher runs, no service is ready. Once the launcher receives a shutdown
+// signal, the check fails regardless of service readiness so that traffic
+// drains before services stop.
+type ReadinessCheck struct {
+	launcher *Launcher
+}
+
+// ReadinessCheck returns a health checker aggregating the readiness of all
+// registered services, including services registered after this call.
+//
+// Returns:
+//   - *ReadinessCheck: Checker named "services"
+//
+// Example:
+//
+//	healthService.AddHealthChecker(launcher.ReadinessCheck())
+func (l *Launcher) ReadinessCheck() *ReadinessCheck {
+	return &ReadinessCheck{launcher: l}
+}
+
+// Name returns the checker name used in /readyz results.
+func (c *ReadinessCheck) Name() string {
+	return "services"
+}
+
+// Check returns an error listing the services that are not ready.
+//
+// Parameters:
+//   - ctx: Unused; readiness is read from memory
+//
+// Returns:
+//   - error: nil if every service is ready and the launcher is not draining
+func (c *ReadinessCheck) Check(ctx context.Context) error {
+	if c.launcher.Draining() {
+		return fmt.Errorf("draining: shutdown in progress")
+	}
+	if notReady := c.launcher.NotReady(); len(notReady) > 0 {
+		return fmt.Errorf("not ready: %s", strings.Join(notReady, ", "))
+	}
+	return nil
+}
+
+// NotReady returns the names of registered services that have not reported
+// ready in the current run, in registration order.
+//
+// Returns:
+//   - []string: Names of services that are not ready, empty if all are
+//
+// Thread Safety: This method is safe for concurrent use.
+func (l *Launcher) NotReady() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var names []string
+	for _, m := range l.services {
+		if !m.isReady() {
+			names = append(names, m.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadinessCheck tests aggregation of service readiness.
+// This verifies the check fails until every service reports ready.
+func TestReadinessCheck(t *testing.T) {
+	events := &eventLog{}
+	launcher := NewLauncher()
+
+	plain := newMockService("plain")
+	plain.blockStart = true
+	require.NoError(t, launcher.AddNamedService("plain", plain))
+	slow := newReadyService("slow", events, 200*time.Millisecond)
+	require.NoError(t, launcher.AddNamedService("slow", slow))
+
+	check := launcher.ReadinessCheck()
+	assert.Equal(t, "services", check.Name())
+
+	err := check.Check(context.Background())
+	require.Error(t, err, "Nothing is ready before the launcher runs")
+	assert.Equal(t, "not ready: plain, slow", err.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- launcher.startServices(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"slow"}, launcher.NotReady())
+	}, time.Second, 5*time.Millisecond, "Services without ReadyNotifier are ready once started")
+
+	require.Eventually(t, func() bool {
+		return check.Check(context.Background()) == nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// RestartMode selects when a service is restarted after Start returns.
+type RestartMode int
+
+const (
+	// RestartNever treats a failed service as fatal: the launcher shuts
+	// down all services and Run returns the failure. This is the default.
+	RestartNever RestartMode = iota
+
+	// RestartOnFailure restarts the service when Start returns an error.
+	// A nil return means the service finished and is not restarted.
+	RestartOnFailure
+
+	// RestartAlways restarts the service whenever Start returns, with or
+	// without an error, until the launcher shuts down.
+	RestartAlways
+)
+
+// String returns the mode name used in logs.
+func (m RestartMode) String() string {
+	switch m {
+	case RestartNever:
+		return "never"
+	case RestartOnFailure:
+		return "on-failure"
+	case RestartAlways:
+		return "always"
+	default:
+		return fmt.Sprintf("RestartMode(%d)", int(m))
+	}
+}
+
+// Default backoff bounds used when a RestartPolicy leaves them unset.
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// RestartPolicy controls how the launcher supervises a service.
+// Restarts are delayed with exponential backoff, starting at InitialBackoff
+// and doubling up to MaxBackoff. A service that ran for at least MaxBackoff
+// before returning is considered to have recovered, and its backoff starts
+// over.
+type RestartPolicy struct {
+	// Mode selects when the service is restarted
+	Mode RestartMode
+
+	// MaxRetries is the maximum number of restarts; 0 means unlimited.
+	// Once exhausted, a failure is treated as fatal.
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first restart (default: 1s)
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between restarts (default: 30s)
+	MaxBackoff time.Duration
+}
+
+// WithRestartPolicy sets the supervision policy for a service registered
+// with AddNamedService. Services registered without it use RestartNever.
+//
+// Parameters:
+//   - policy: Restart policy for the service
+//
+// Returns:
+//   - ServiceOption: Option for AddNamedService
+//
+// Example:
+//
+//	launcher.AddNamedService("consumer", consumer, service.WithRestartPolicy(service.RestartPolicy{
+//	    Mode:           service.RestartOnFailure,
+//	    MaxRetries:     5,
+//	    InitialBackoff: 500 * time.Millisecond,
+//	}))
+func WithRestartPolicy(policy RestartPolicy) ServiceOption {
+	return func(m *managedService) {
+		m.restart = policy
+	}
+}
+
+// shouldRestart reports whether a Start that returned err is restarted.
+func (p RestartPolicy) shouldRestart(err error) bool {
+	switch p.Mode {
+	case RestartOnFailure:
+		return err != nil
+	case RestartAlways:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxBackoff returns MaxBackoff or its default.
+func (p RestartPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff > 0 {
+		return p.MaxBackoff
+	}
+	return defaultMaxBackoff
+}
+
+// backoff returns the delay before the restart following the given number
+// of consecutive restarts.
+func (p RestartPolicy) backoff(consecutive int) time.Duration {
+	delay := p.InitialBackoff
+	if delay <= 0 {
+		delay = defaultInitialBackoff
+	}
+	limit := p.maxBackoff()
+	for i := 0; i < consecutive && delay < limit; i++ {
+		delay *= 2
+	}
+	if delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// supervise runs m's Start, restarting it according to its policy until it
+// returns for good. Returns the error that ended supervision, or nil.
+func (l *Launcher) supervise(m *managedService) error {
+	policy := m.restart
+	restarts, consecutive := 0, 0
+
+	for {
+		started := time.Now()
+		m.starting()
+		attemptDone := make(chan struct{})
+		l.watchReady(m, attemptDone)
+
+		err := m.svc.Start(m.ctx)
+		close(attemptDone)
+
+		stopped := m.stopping.Load() || m.ctx.Err() != nil
+		if err != nil && !stopped {
+			l.metrics.recordFailure(m.name)
+		}
+		if stopped || !policy.shouldRestart(err) {
+			return err
+		}
+
+		if policy.MaxRetries > 0 && restarts >= policy.MaxRetries {
+			if err == nil {
+				return nil
+			}
+			return fmt.Errorf("restart limit of %d reached: %w", policy.MaxRetries, err)
+		}
+
+		// A service that ran stably has recovered; start the backoff over
+		if time.Since(started) >= policy.maxBackoff() {
+			consecutive = 0
+		}
+		delay := policy.backoff(consecutive)
+		consecutive++
+		restarts++
+
+		fields := []log.Field{
+			{Key: "name", Value: m.name},
+			{Key: "policy", Value: policy.Mode.String()},
+			{Key: "restart", Value: restarts},
+			{Key: "delay", Value: delay},
+		}
+		if err != nil {
+			fields = append(fields, log.Field{Key: "error", Value: err})
+		}
+		l.logger.Warn("Restarting service", fields...)
+
+		m.restarting(err)
+		m.resetReady()
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-m.ctx.Done():
+			timer.Stop()
+			return err
+		}
+		l.metrics.restarts.WithLabelValues(m.name).Inc()
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyService fails its first failures starts, then blocks until canceled.
+type flakyService struct {
+	failures int32
+	starts   atomic.Int32
+	err      error
+}
+
+func (s *flakyService) Start(ctx context.Context) error {
+	if s.starts.Add(1) <= s.failures {
+		return s.err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (s *flakyService) Stop(ctx context.Context) error {
+	return nil
+}
+
+// exitingService returns from Start immediately with a nil error.
+type exitingService struct {
+	starts atomic.Int32
+}
+
+func (s *exitingService) Start(ctx context.Context) error {
+	s.starts.Add(1)
+	return nil
+}
+
+func (s *exitingService) Stop(ctx context.Context) error {
+	return nil
+}
+
+var fastRestart = RestartPolicy{
+	Mode:           RestartOnFailure,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+}
+
+// TestRestartPolicy_Backoff tests the delay between restarts.
+// This verifies the delay doubles from the initial backoff up to the cap.
+func TestRestartPolicy_Backoff(t *testing.T) {
+	policy := RestartPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(0))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 800*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(4))
+	assert.Equal(t, time.Second, policy.backoff(50))
+
+	assert.Equal(t, defaultInitialBackoff, RestartPolicy{}.backoff(0))
+	assert.Equal(t, defaultMaxBackoff, RestartPolicy{}.backoff(50))
+}
+
+// TestRestartOnFailure_Recovers tests restarting a failing service.
+// This verifies the service keeps running after transient failures and metrics are recorded.
+func TestRestartOnFailure_Recovers(t *testing.T) {
+	launcher := NewLauncher()
+	svc := &flakyService{failures: 2, err: errors.New("connection refused")}
+	require.NoError(t, launcher.AddNamedService("consumer", svc, WithRestartPolicy(fastRestart)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- launcher.startServices(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return svc.starts.Load() == 3 && len(launcher.NotReady()) == 0
+	}, 2*time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(launcher.metrics.restarts.WithLabelValues("consumer")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(launcher.metrics.failures.WithLabelValues("consumer")))
+	assert.Positive(t, testutil.ToFloat64(launcher.metrics.lastFailure.WithLabelValues("consumer")))
+
+	// Error messages stay out of label values
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(launcher.Collectors()...)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				assert.Equal(t, "service", label.GetName(), family.GetName())
+				assert.Equal(t, "consumer", label.GetValue(), family.GetName())
+			}
+		}
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+// TestRestartOnFailure_MaxRetries tests exhausting the retry limit.
+// This verifies the launcher fails with a ServiceError once retries run out.
+func TestRestartOnFailure_MaxRetries(t *testing.T) {
+	launcher := NewLauncher()
+	policy := fastRestart
+	policy.MaxRetries = 3
+	svc := &flakyService{failures: 100, err: errors.New("boom")}
+	require.NoError(t, launcher.AddNamedService("consumer", svc, WithRestartPolicy(policy)))
+
+	err := launcher.startServices(context.Background())
+	require.Error(t, err)
+
+	var svcErr *ServiceError
+	require.ErrorAs(t, err, &svcErr)
+	assert.Equal(t, "consumer", svcErr.Name)
+	assert.Contains(t, err.Error(), "restart limit of 3 reached")
+	assert.Equal(t, int32(4), svc.starts.Load())
+	assert.Equal(t, 3.0, testutil.ToFloat64(launcher.metrics.restarts.WithLabelValues("consumer")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(launcher.metrics.failures.WithLabelValues("consumer")))
+}
+
+// TestRestartAlways tests restarting a service that exits cleanly.
+// This verifies RestartAlways restarts nil returns while RestartOnFailure does not.
+func TestRestartAlways(t *testing.T) {
+	always := &exitingService{}
+	once := &exitingService{}
+	launcher := NewLauncher()
+	policy := fastRestart
+	policy.Mode = RestartAlways
+	require.NoError(t, launcher.AddNamedService("always", always, WithRestartPolicy(policy)))
+	require.NoError(t, launcher.AddNamedService("once", once, WithRestartPolicy(fastRestart)))
+	require.NoError(t, launcher.AddNamedService("blocker", &flakyService{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- launcher.startServices(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return always.starts.Load() >= 3
+	}, 2*time.Second, 5*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, int32(1), once.starts.Load())
+}
+
+// TestRestartNever_Default tests services registered without a policy.
+// This verifies a failure is fatal and not retried.
+func TestRestartNever_Default(t *testing.T) {
+	launcher := NewLauncher()
+	svc := &flakyService{failures: 1, err: errors.New("boom")}
+	require.NoError(t, launcher.AddNamedService("worker", svc))
+
+	err := launcher.startServices(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, int32(1), svc.starts.Load())
+	assert.Equal(t, 0.0, testutil.ToFloat64(launcher.metrics.restarts.WithLabelValues("worker")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(launcher.metrics.failures.WithLabelValues("worker")))
+	assert.Len(t, launcher.Collectors(), 4)
+}
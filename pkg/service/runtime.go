@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// runState tracks the services of the run in progress.
+type runState struct {
+	// ctx is the parent of each service's context
+	ctx context.Context
+
+	// failed receives the first service error
+	failed chan error
+
+	// active counts the services that have not exited yet
+	active int
+
+	// allExited is closed once every service has exited
+	allExited chan struct{}
+
+	// stopping is set once shutdown has begun
+	stopping bool
+}
+
+// closed reports whether services can no longer join the run, because it
+// is shutting down or every service has already exited.
+func (r *runState) closed() bool {
+	return r.stopping || r.active == 0
+}
+
+// register adds m to the registered services. While the launcher is
+// running, m is started at once. The caller must hold l.mu.
+func (l *Launcher) register(m *managedService) error {
+	run := l.run
+	if run == nil {
+		l.services = append(l.services, m)
+		return nil
+	}
+
+	if run.closed() {
+		return fmt.Errorf("cannot add service %s: launcher is shutting down", m.name)
+	}
+	for _, dep := range m.dependsOn {
+		if l.findService(dep) == nil {
+			return fmt.Errorf("service %s depends on unknown service %s", m.name, dep)
+		}
+	}
+
+	l.services = append(l.services, m)
+	m.reset(run.ctx)
+	l.launch(run, m, len(l.services)-1)
+
+	l.logger.Info("Service added to running launcher",
+		log.Field{Key: "name", Value: m.name},
+		log.Field{Key: "type", Value: fmt.Sprintf("%T", m.svc)})
+	return nil
+}
+
+// launch runs m in its own goroutine as part of run, once its dependencies
+// are ready. The caller must hold l.mu and have reset m.
+func (l *Launcher) launch(run *runState, m *managedService, index int) {
+	run.active++
+
+	go func() {
+		if err := l.runService(m, index); err != nil {
+			if m.removed.Load() {
+				l.logger.Warn("Removed service exited with error",
+					log.Field{Key: "name", Value: m.name},
+					log.Field{Key: "error", Value: err})
+			} else {
+				select {
+				case run.failed <- &ServiceError{Name: m.name, Err: err}:
+				default:
+				}
+			}
+		}
+		close(m.exited)
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		run.active--
+		if run.active == 0 {
+			close(run.allExited)
+		}
+	}()
+}
+
+// runService waits for m's dependencies, then runs it under supervision.
+// Returns the error that ended the service, or nil.
+func (l *Launcher) runService(m *managedService, index int) error {
+	if err := l.awaitDependencies(m); err != nil {
+		m.finished(err)
+		return err
+	}
+	if m.ctx.Err() != nil {
+		m.finished(nil)
+		return nil
+	}
+
+	l.logger.Info("Starting service",
+		log.Field{Key: "index", Value: index},
+		log.Field{Key: "name", Value: m.name},
+		log.Field{Key: "type", Value: fmt.Sprintf("%T", m.svc)})
+
+	err := l.supervise(m)
+	m.finished(err)
+	return err
+}
+
+// RemoveService unregisters the named service. While the launcher is
+// running, the service is stopped first, within its stop budget (see
+// StopTimeout) and ctx; its exit is not treated as a failure. A service
+// that other services depend on cannot be removed.
+//
+// Parameters:
+//   - ctx: Context bounding how long to wait for the service to stop
+//   - name: Name of the service to remove
+//
+// Returns:
+//   - error: Returns error if the service is unknown, required by another
+//     service or the launcher is shutting down, or a *StopError if the
+//     service failed to stop in time. The service is removed either way
+//     once stopping has begun.
+//
+// Thread Safety: This method is safe for concurrent use.
+//
+// Example:
+//
+//	if err := launcher.RemoveService(ctx, "plugin-billing"); err != nil {
+//	    log.Warn("Failed to remove plugin", log.Field{Key: "error", Value: err})
+//	}
+func (l *Launcher) RemoveService(ctx context.Context, name string) error {
+	l.mu.Lock()
+	m := l.findService(name)
+	if m == nil {
+		l.mu.Unlock()
+		return fmt.Errorf("service %s not registered", name)
+	}
+	for _, other := range l.services {
+		if slices.Contains(other.dependsOn, name) {
+			l.mu.Unlock()
+			return fmt.Errorf("service %s is required by service %s", name, other.name)
+		}
+	}
+	run := l.run
+	if run != nil && run.stopping {
+		l.mu.Unlock()
+		return fmt.Errorf("cannot remove service %s: launcher is shutting down", name)
+	}
+	m.removed.Store(true)
+	l.services = slices.DeleteFunc(l.services, func(s *managedService) bool { return s == m })
+	l.mu.Unlock()
+
+	l.metrics.forget(name)
+	if run == nil {
+		l.logger.Info("Service removed", log.Field{Key: "name", Value: name})
+		return nil
+	}
+
+	result := l.stopService(ctx, m)
+	l.logger.Info("Service removed from running launcher",
+		log.Field{Key: "name", Value: name},
+		log.Field{Key: "duration", Value: result.Duration})
+	if result.Err != nil {
+		return &StopError{Name: name, Err: result.Err}
+	}
+	return nil
+}
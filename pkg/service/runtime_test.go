@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// interruptedService blocks in Start until canceled, then returns an error.
+type interruptedService struct {
+	stopCalled atomic.Int32
+}
+
+func (s *interruptedService) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return errors.New("interrupted")
+}
+
+func (s *interruptedService) Stop(ctx context.Context) error {
+	s.stopCalled.Add(1)
+	return nil
+}
+
+// TestAddNamedService_WhileRunning tests adding services to a running launcher.
+// This verifies added services start after their dependencies and stop with the others.
+func TestAddNamedService_WhileRunning(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+	require.NoError(t, launcher.AddNamedService("db", newReadyService("db", events, 0)))
+	stop := runUntilStarted(t, launcher)
+
+	err := launcher.AddNamedService("orphan", newMockService("orphan"), DependsOn("missing"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown service missing")
+
+	require.NoError(t, launcher.AddNamedService("plugin", newReadyService("plugin", events, 10*time.Millisecond),
+		DependsOn("db")))
+	require.Eventually(t, func() bool {
+		return len(launcher.NotReady()) == 0
+	}, 2*time.Second, 5*time.Millisecond)
+	assert.Len(t, launcher.Services(), 2)
+
+	require.NoError(t, stop())
+	assert.Equal(t, []string{"start db", "ready db", "start plugin", "ready plugin", "stop plugin", "stop db"}, events.list())
+
+	// Services added after the launcher stopped are only registered
+	require.NoError(t, launcher.AddNamedService("late", newMockService("late")))
+	assert.Equal(t, []string{"late"}, launcher.NotReady())
+}
+
+// TestRemoveService_WhileRunning tests removing a service from a running launcher.
+// This verifies the service is stopped without failing the launcher and dependencies are protected.
+func TestRemoveService_WhileRunning(t *testing.T) {
+	launcher := NewLauncher()
+	events := &eventLog{}
+	plugin := &interruptedService{}
+	require.NoError(t, launcher.AddNamedService("db", newReadyService("db", events, 0)))
+	require.NoError(t, launcher.AddNamedService("api", newReadyService("api", events, 0), DependsOn("db")))
+	require.NoError(t, launcher.AddNamedService("plugin", plugin))
+	stop := runUntilStarted(t, launcher)
+
+	err := launcher.RemoveService(context.Background(), "db")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "required by service api")
+	assert.Error(t, launcher.RemoveService(context.Background(), "unknown"))
+
+	require.NoError(t, launcher.RemoveService(context.Background(), "plugin"))
+	assert.Equal(t, int32(1), plugin.stopCalled.Load())
+	assert.Len(t, launcher.Services(), 2)
+
+	// The plugin's error on exit must not stop the remaining services
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, launcher.NotReady())
+
+	require.NoError(t, launcher.RemoveService(context.Background(), "api"))
+	require.NoError(t, stop())
+	assert.Equal(t, int32(1), plugin.stopCalled.Load())
+
+	report := launcher.ShutdownReport()
+	require.Len(t, report.Services, 1)
+	assert.Equal(t, "db", report.Services[0].Name)
+}
+
+// TestRemoveService_BeforeRun tests removing a service that never ran.
+// This verifies the service is unregistered without being stopped.
+func TestRemoveService_BeforeRun(t *testing.T) {
+	launcher := NewLauncher()
+	svc := newMockService("worker")
+	require.NoError(t, launcher.AddNamedService("worker", svc))
+
+	require.NoError(t, launcher.RemoveService(context.Background(), "worker"))
+	assert.Empty(t, launcher.Services())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&svc.stopCalled))
+
+	// The name can be reused once removed
+	require.NoError(t, launcher.AddNamedService("worker", svc))
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// ShutdownReport describes how the services were stopped during the latest
+// shutdown of a Launcher.
+type ShutdownReport struct {
+	// Duration is how long the whole shutdown took
+	Duration time.Duration
+
+	// Services lists every service in the order stopping began
+	Services []ServiceStopReport
+}
+
+// ServiceStopReport describes how a single service was stopped.
+type ServiceStopReport struct {
+	// Name is the name of the service
+	Name string
+
+	// Budget is the time the service was given to stop, capped by the time
+	// left of the launcher's shutdown timeout
+	Budget time.Duration
+
+	// Duration is how long the service took to stop, or its budget if it
+	// was abandoned
+	Duration time.Duration
+
+	// Err is the error from Stop, or the budget's context error if the
+	// service was abandoned
+	Err error
+
+	// Abandoned is true if Stop or Start did not return within the budget.
+	// The launcher moved on without waiting for the service to exit.
+	Abandoned bool
+}
+
+// Abandoned returns the names of services that exceeded their stop budget.
+//
+// Returns:
+//   - []string: Names of abandoned services, in stop order
+func (r *ShutdownReport) Abandoned() []string {
+	var names []string
+	for _, s := range r.Services {
+		if s.Abandoned {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// StopTimeout sets how long a service is given to stop during shutdown,
+// overriding the launcher default set with SetStopTimeout. The budget is
+// capped by the time left of the launcher's shutdown timeout. A service
+// that does not stop within its budget is abandoned and reported in the
+// ShutdownReport.
+//
+// Parameters:
+//   - timeout: Stop budget of the service
+//
+// Returns:
+//   - ServiceOption: Option for AddNamedService
+//
+// Example:
+//
+//	launcher.AddNamedService("consumer", consumer, service.StopTimeout(20*time.Second))
+func StopTimeout(timeout time.Duration) ServiceOption {
+	return func(m *managedService) {
+		m.stopTimeout = timeout
+	}
+}
+
+// SetStopTimeout configures the default stop budget of each service.
+// Services registered with StopTimeout use their own budget instead.
+// Budgets never extend past the shutdown timeout, which caps the whole
+// shutdown.
+//
+// Parameters:
+//   - timeout: Stop budget per service; zero gives each service whatever
+//     is left of the shutdown timeout
+//
+// Default: 0
+func (l *Launcher) SetStopTimeout(timeout time.Duration) {
+	l.stopTimeout = timeout
+}
+
+// SetParallelStop configures whether independent services stop
+// concurrently. When enabled, services are stopped in groups: a group holds
+// every service that no still-running service depends on, and the next
+// group starts stopping once the current one has stopped. When disabled,
+// services stop one at a time.
+//
+// Parameters:
+//   - parallel: Whether to stop independent services concurrently
+//
+// Default: false
+func (l *Launcher) SetParallelStop(parallel bool) {
+	l.parallelStop = parallel
+}
+
+// ShutdownReport returns the report of the latest shutdown, or nil if the
+// launcher has not shut down yet.
+//
+// Returns:
+//   - *ShutdownReport: Report of the latest shutdown
+//
+// Thread Safety: This method is safe for concurrent use.
+//
+// Example:
+//
+//	if report := launcher.ShutdownReport(); report != nil && len(report.Abandoned()) > 0 {
+//	    log.Warn("Services abandoned during shutdown",
+//	        log.Field{Key: "services", Value: report.Abandoned()})
+//	}
+func (l *Launcher) ShutdownReport() *ShutdownReport {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lastShutdown
+}
+
+// shutdown performs graceful shutdown of all services.
+// Services are stopped in reverse dependency order, so a service stops
+// before the services it depends on; unrelated services stop in reverse
+// registration order, or concurrently if parallel stop is enabled. Each
+// service is given its stop budget for Stop and Start to return, and the
+// shutdown timeout caps the whole shutdown.
+// Returns the StopErrors of all services that failed to stop, joined.
+func (l *Launcher) shutdown() error {
+	l.logger.Info("Initiating graceful shutdown",
+		log.Field{Key: "timeout", Value: l.shutdownTimeout},
+		log.Field{Key: "parallel", Value: l.parallelStop})
+	start := time.Now()
+
+	// The shutdown timeout caps every service's budget
+	ctx, cancel := context.WithTimeout(context.Background(), l.shutdownTimeout)
+	defer cancel()
+
+	beforeStop := l.hooksFor(func(h *lifecycleHooks) []Hook { return h.beforeStop })
+	hookErr := l.runHooks(ctx, "before-stop", beforeStop, false)
+
+	// Services can no longer be added or removed once stopping begins
+	l.mu.Lock()
+	if l.run != nil {
+		l.run.stopping = true
+	}
+	groups := l.stopGroups()
+	l.mu.Unlock()
+
+	report := &ShutdownReport{}
+	for _, group := range groups {
+		results := make([]ServiceStopReport, len(group))
+		if l.parallelStop {
+			var wg sync.WaitGroup
+			for i, m := range group {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					results[i] = l.stopService(ctx, m)
+				}()
+			}
+			wg.Wait()
+		} else {
+			for i, m := range group {
+				results[i] = l.stopService(ctx, m)
+			}
+		}
+		report.Services = append(report.Services, results...)
+	}
+	report.Duration = time.Since(start)
+
+	l.mu.Lock()
+	l.lastShutdown = report
+	l.mu.Unlock()
+
+	var errs []error
+	if hookErr != nil {
+		errs = append(errs, hookErr)
+	}
+	for _, s := range report.Services {
+		if s.Err != nil {
+			errs = append(errs, &StopError{Name: s.Name, Err: s.Err})
+		}
+	}
+
+	if len(errs) > 0 {
+		l.logger.Warn("Graceful shutdown completed with errors",
+			log.Field{Key: "error_count", Value: len(errs)},
+			log.Field{Key: "abandoned", Value: report.Abandoned()},
+			log.Field{Key: "duration", Value: report.Duration})
+		return errors.Join(errs...)
+	}
+
+	l.logger.Info("Graceful shutdown completed",
+		log.Field{Key: "duration", Value: report.Duration})
+	return nil
+}
+
+// stopService stops m within its budget and reports the outcome.
+// A Stop call that outlives the budget keeps running in the background.
+func (l *Launcher) stopService(ctx context.Context, m *managedService) ServiceStopReport {
+	budget := m.stopTimeout
+	if budget <= 0 {
+		budget = l.stopTimeout
+	}
+	if budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+	}
+
+	result := ServiceStopReport{Name: m.name}
+	if deadline, ok := ctx.Deadline(); ok {
+		result.Budget = time.Until(deadline).Round(time.Millisecond)
+	}
+
+	l.logger.Debug("Stopping service",
+		log.Field{Key: "name", Value: m.name},
+		log.Field{Key: "type", Value: fmt.Sprintf("%T", m.svc)},
+		log.Field{Key: "budget", Value: result.Budget})
+	start := time.Now()
+
+	m.stopping.Store(true)
+	m.markStopping()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- m.svc.Stop(ctx)
+	}()
+
+	select {
+	case result.Err = <-stopped:
+		if result.Err != nil {
+			l.logger.Error("Failed to stop service",
+				log.Field{Key: "name", Value: m.name},
+				log.Field{Key: "error", Value: result.Err})
+		}
+	case <-ctx.Done():
+		result.Abandoned = true
+	}
+	m.cancel()
+
+	if !result.Abandoned {
+		select {
+		case <-m.exited:
+		case <-ctx.Done():
+			result.Abandoned = true
+		}
+	}
+	result.Duration = time.Since(start)
+
+	if result.Abandoned {
+		l.logger.Warn("Service did not stop within its budget, abandoning it",
+			log.Field{Key: "name", Value: m.name},
+			log.Field{Key: "budget", Value: result.Budget})
+		if result.Err == nil {
+			result.Err = ctx.Err()
+		}
+	}
+	return result
+}
+
+// stopGroups returns the services grouped in stop order. Without parallel
+// stop every group holds a single service, in reverse start order. With
+// parallel stop a group holds every service that no service in a later
+// group depends on. The caller must hold l.mu.
+func (l *Launcher) stopGroups() [][]*managedService {
+	order := l.startOrder()
+	var groups [][]*managedService
+
+	if !l.parallelStop {
+		for i := len(order) - 1; i >= 0; i-- {
+			groups = append(groups, []*managedService{order[i]})
+		}
+		return groups
+	}
+
+	stopped := make(map[string]bool, len(order))
+	for len(stopped) < len(order) {
+		var group []*managedService
+		for i := len(order) - 1; i >= 0; i-- {
+			m := order[i]
+			if !stopped[m.name] && !l.hasRunningDependents(m, order, stopped) {
+				group = append(group, m)
+			}
+		}
+		for _, m := range group {
+			stopped[m.name] = true
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// hasRunningDependents reports whether a service in order that is not yet
+// stopped depends on m.
+func (l *Launcher) hasRunningDependents(m *managedService, order []*managedService, stopped map[string]bool) bool {
+	for _, other := range order {
+		if stopped[other.name] {
+			continue
+		}
+		for _, dep := range other.dependsOn {
+			if dep == m.name {
+				return true
+			}
+		}
+	}
+	return false
+}
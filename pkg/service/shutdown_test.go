@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowStopService blocks in Start until canceled and takes stopDelay to
+// stop, ignoring the stop context.
+type slowStopService struct {
+	stopDelay time.Duration
+}
+
+func (s *slowStopService) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (s *slowStopService) Stop(ctx context.Context) error {
+	time.Sleep(s.stopDelay)
+	return nil
+}
+
+// runUntilStarted starts the launcher's services and returns a function
+// that cancels them and waits for the shutdown result.
+func runUntilStarted(t *testing.T, launcher *Launcher) func() error {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- launcher.startServices(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return len(launcher.NotReady()) == 0
+	}, 2*time.Second, 5*time.Millisecond)
+
+	return func() error {
+		cancel()
+		return <-done
+	}
+}
+
+// TestShutdown_PerServiceBudget tests stop budgets of individual services.
+// This verifies a slow service is abandoned without starving the others.
+func TestShutdown_PerServiceBudget(t *testing.T) {
+	launcher := NewLauncher()
+	assert.Nil(t, launcher.ShutdownReport())
+
+	fast := newMockService("fast")
+	fast.blockStart = true
+	require.NoError(t, launcher.AddNamedService("fast", fast))
+	require.NoError(t, launcher.AddNamedService("slow", &slowStopService{stopDelay: time.Second},
+		StopTimeout(30*time.Millisecond)))
+
+	start := time.Now()
+	err := runUntilStarted(t, launcher)()
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	var stopErr *StopError
+	require.ErrorAs(t, err, &stopErr)
+	assert.Equal(t, "slow", stopErr.Name)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	report := launcher.ShutdownReport()
+	require.NotNil(t, report)
+	require.Len(t, report.Services, 2)
+	assert.Equal(t, "slow", report.Services[0].Name)
+	assert.True(t, report.Services[0].Abandoned)
+	assert.Equal(t, 30*time.Millisecond, report.Services[0].Budget)
+	assert.Equal(t, "fast", report.Services[1].Name)
+	assert.False(t, report.Services[1].Abandoned)
+	assert.NoError(t, report.Services[1].Err)
+	assert.Equal(t, []string{"slow"}, report.Abandoned())
+}
+
+// TestShutdown_GlobalCap tests the shutdown timeout across services.
+// This verifies services left when the shutdown timeout expires are abandoned at once.
+func TestShutdown_GlobalCap(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.SetShutdownTimeout(50 * time.Millisecond)
+	launcher.SetStopTimeout(time.Minute)
+	require.NoError(t, launcher.AddNamedService("first", &slowStopService{stopDelay: time.Second}))
+	require.NoError(t, launcher.AddNamedService("second", &slowStopService{stopDelay: time.Second}))
+
+	start := time.Now()
+	err := runUntilStarted(t, launcher)()
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	require.Error(t, err)
+
+	report := launcher.ShutdownReport()
+	assert.Equal(t, []string{"second", "first"}, report.Abandoned())
+	assert.LessOrEqual(t, report.Services[0].Budget, 50*time.Millisecond)
+}
+
+// TestShutdown_ParallelStop tests stopping independent services concurrently.
+// This verifies stop groups respect dependencies and overlap their stop time.
+func TestShutdown_ParallelStop(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.SetParallelStop(true)
+	require.NoError(t, launcher.AddNamedService("db", &slowStopService{stopDelay: 50 * time.Millisecond}))
+	require.NoError(t, launcher.AddNamedService("cache", &slowStopService{stopDelay: 50 * time.Millisecond}))
+	require.NoError(t, launcher.AddNamedService("api", &slowStopService{stopDelay: 50 * time.Millisecond},
+		DependsOn("db")))
+
+	var names [][]string
+	for _, group := range launcher.stopGroups() {
+		var g []string
+		for _, m := range group {
+			g = append(g, m.name)
+		}
+		names = append(names, g)
+	}
+	assert.Equal(t, [][]string{{"api", "cache"}, {"db"}}, names)
+
+	start := time.Now()
+	require.NoError(t, runUntilStarted(t, launcher)())
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond, "db should stop after api")
+	assert.Less(t, elapsed, 150*time.Millisecond, "api and cache should stop together")
+
+	report := launcher.ShutdownReport()
+	require.Len(t, report.Services, 3)
+	assert.Equal(t, "db", report.Services[2].Name)
+	assert.Empty(t, report.Abandoned())
+}
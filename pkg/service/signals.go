@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/eggybyte-technology/go-eggybyte-core/pkg/log"
+)
+
+// ReloadFunc reloads configuration in response to a reload signal.
+type ReloadFunc func(ctx context.Context) error
+
+// SetShutdownSignals configures the signals that trigger a graceful
+// shutdown from Run. Calling it with no signals disables signal-triggered
+// shutdown; Run then stops only when its context is canceled.
+//
+// Parameters:
+//   - sigs: Shutdown signals
+//
+// Default: SIGINT and SIGTERM
+func (l *Launcher) SetShutdownSignals(sigs ...os.Signal) {
+	l.shutdownSignals = sigs
+}
+
+// SetDrainPeriod configures how long Run waits between receiving a
+// shutdown signal and stopping services. During the drain period the
+// ReadinessCheck fails, so load balancers stop routing new traffic while
+// in-flight requests complete. A second shutdown signal ends the drain
+// period early. Cancelling Run's context stops services without draining.
+//
+// Parameters:
+//   - period: Drain duration; zero stops services immediately
+//
+// Default: 0 (no drain)
+func (l *Launcher) SetDrainPeriod(period time.Duration) {
+	l.drainPeriod = period
+}
+
+// SetReloadFunc configures the function Run calls when a reload signal is
+// received. Reload signals do not stop the launcher, and a failed reload
+// is logged without affecting running services. Without a reload function,
+// reload signals are not intercepted.
+//
+// Parameters:
+//   - fn: Function reloading configuration
+//
+// Example:
+//
+//	launcher.SetReloadFunc(func(ctx context.Context) error {
+//	    return config.UpdateFrom(config.ValueSource{Source: config.SourceFile}, readOverrides())
+//	})
+func (l *Launcher) SetReloadFunc(fn ReloadFunc) {
+	l.reloadFunc = fn
+}
+
+// SetReloadSignals configures the signals that trigger the reload function.
+//
+// Parameters:
+//   - sigs: Reload signals
+//
+// Default: SIGHUP
+func (l *Launcher) SetReloadSignals(sigs ...os.Signal) {
+	l.reloadSignals = sigs
+}
+
+// Draining reports whether Run has received a shutdown signal and is
+// waiting out the drain period or stopping services.
+//
+// Returns:
+//   - bool: True once shutdown has begun
+func (l *Launcher) Draining() bool {
+	return l.draining.Load()
+}
+
+// defaultShutdownSignals are the signals handled by a new Launcher.
+func defaultShutdownSignals() []os.Signal {
+	return []os.Signal{os.Interrupt, syscall.SIGTERM}
+}
+
+// notifySignals subscribes to the shutdown and, if a reload function is
+// set, reload signals. Returns the signal channel and a function that
+// unsubscribes it.
+func (l *Launcher) notifySignals() (<-chan os.Signal, func()) {
+	sigs := slices.Clone(l.shutdownSignals)
+	if l.reloadFunc != nil {
+		sigs = append(sigs, l.reloadSignals...)
+	}
+
+	ch := make(chan os.Signal, 1)
+	if len(sigs) == 0 {
+		return ch, func() {}
+	}
+	signal.Notify(ch, sigs...)
+	return ch, func() { signal.Stop(ch) }
+}
+
+// handleSignals reloads on reload signals and, on the first shutdown
+// signal, marks the launcher as draining, waits out the drain period and
+// calls stop. It returns when ctx is canceled or stop has been called.
+func (l *Launcher) handleSignals(ctx context.Context, sigCh <-chan os.Signal, stop context.CancelFunc) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-sigCh:
+			if l.isReloadSignal(sig) {
+				l.reload(ctx, sig)
+				continue
+			}
+
+			l.logger.Info("Received shutdown signal",
+				log.Field{Key: "signal", Value: sig.String()},
+				log.Field{Key: "drain_period", Value: l.drainPeriod})
+			l.draining.Store(true)
+			l.drain(ctx, sigCh)
+			stop()
+			return
+		}
+	}
+}
+
+// drain waits for the drain period, handling reload signals meanwhile.
+// A second shutdown signal or ctx cancellation ends the wait early.
+func (l *Launcher) drain(ctx context.Context, sigCh <-chan os.Signal) {
+	if l.drainPeriod <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(l.drainPeriod)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			l.logger.Info("Drain period completed")
+			return
+		case <-ctx.Done():
+			return
+		case sig := <-sigCh:
+			if l.isReloadSignal(sig) {
+				l.reload(ctx, sig)
+				continue
+			}
+			l.logger.Warn("Received second shutdown signal, skipping remaining drain period",
+				log.Field{Key: "signal", Value: sig.String()})
+			return
+		}
+	}
+}
+
+// isReloadSignal reports whether sig triggers a reload.
+func (l *Launcher) isReloadSignal(sig os.Signal) bool {
+	return l.reloadFunc != nil && slices.Contains(l.reloadSignals, sig)
+}
+
+// reload runs the reload function and logs its outcome.
+func (l *Launcher) reload(ctx context.Context, sig os.Signal) {
+	l.logger.Info("Reloading configuration", log.Field{Key: "signal", Value: sig.String()})
+	start := time.Now()
+
+	if err := l.reloadFunc(ctx); err != nil {
+		l.logger.Error("Configuration reload failed",
+			log.Field{Key: "signal", Value: sig.String()},
+			log.Field{Key: "error", Value: err})
+		return
+	}
+
+	l.logger.Info("Configuration reloaded",
+		log.Field{Key: "duration", Value: time.Since(start)})
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runWithSignals starts launcher.Run with SIGUSR1 as the shutdown signal
+// and SIGUSR2 as the reload signal, and waits until all services are ready.
+func runWithSignals(t *testing.T, launcher *Launcher, svc Service) <-chan error {
+	t.Helper()
+
+	launcher.SetShutdownSignals(syscall.SIGUSR1)
+	launcher.SetReloadSignals(syscall.SIGUSR2)
+	require.NoError(t, launcher.AddNamedService("worker", svc))
+
+	done := make(chan error, 1)
+	go func() { done <- launcher.Run(context.Background()) }()
+
+	require.Eventually(t, func() bool {
+		return launcher.ReadinessCheck().Check(context.Background()) == nil
+	}, 2*time.Second, 5*time.Millisecond)
+	return done
+}
+
+// TestRun_DrainBeforeStop tests the drain phase after a shutdown signal.
+// This verifies readiness fails immediately and services stop only after the drain period.
+func TestRun_DrainBeforeStop(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.SetDrainPeriod(150 * time.Millisecond)
+	svc := newMockService("worker")
+	svc.blockStart = true
+	done := runWithSignals(t, launcher, svc)
+
+	start := time.Now()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+
+	require.Eventually(t, launcher.Draining, time.Second, time.Millisecond)
+	err := launcher.ReadinessCheck().Check(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "draining")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&svc.stopCalled), "Services should keep running while draining")
+
+	require.NoError(t, <-done)
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&svc.stopCalled))
+}
+
+// TestRun_SecondSignalSkipsDrain tests interrupting the drain period.
+// This verifies a second shutdown signal stops services immediately.
+func TestRun_SecondSignalSkipsDrain(t *testing.T) {
+	launcher := NewLauncher()
+	launcher.SetDrainPeriod(time.Minute)
+	svc := newMockService("worker")
+	svc.blockStart = true
+	done := runWithSignals(t, launcher, svc)
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+	require.Eventually(t, launcher.Draining, time.Second, time.Millisecond)
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after second shutdown signal")
+	}
+}
+
+// TestRun_ReloadSignal tests reloading configuration on a reload signal.
+// This verifies reloads, including failed ones, keep services running.
+func TestRun_ReloadSignal(t *testing.T) {
+	launcher := NewLauncher()
+	var reloads atomic.Int32
+	launcher.SetReloadFunc(func(ctx context.Context) error {
+		if reloads.Add(1) == 1 {
+			return errors.New("bad config")
+		}
+		return nil
+	})
+	svc := newMockService("worker")
+	svc.blockStart = true
+	done := runWithSignals(t, launcher, svc)
+
+	for want := int32(1); want <= 2; want++ {
+		require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR2))
+		require.Eventually(t, func() bool { return reloads.Load() == want }, time.Second, time.Millisecond)
+	}
+	assert.False(t, launcher.Draining())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&svc.stopCalled))
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+	require.NoError(t, <-done)
+}
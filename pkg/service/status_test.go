@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusOf returns the status of the named service, or a zero status.
+func statusOf(launcher *Launcher, name string) ServiceStatus {
+	for _, s := range launcher.Status() {
+		if s.Name == name {
+			return s
+		}
+	}
+	return ServiceStatus{}
+}
+
+// TestStatus_States tests the state reported for each service.
+// This verifies restarts, failures and stops are reflected in the snapshot.
+func TestStatus_States(t *testing.T) {
+	launcher := NewLauncher()
+	worker := newMockService("worker")
+	worker.blockStart = true
+	require.NoError(t, launcher.AddNamedService("worker", worker))
+	require.NoError(t, launcher.AddNamedService("consumer", &flakyService{failures: 1000, err: errors.New("broker unavailable")},
+		WithRestartPolicy(RestartPolicy{Mode: RestartOnFailure, InitialBackoff: time.Hour})))
+	require.NoError(t, launcher.AddNamedService("job", &flakyService{failures: 1, err: errors.New("bad input")},
+		WithRestartPolicy(RestartPolicy{Mode: RestartOnFailure, MaxRetries: 1, InitialBackoff: time.Millisecond})))
+
+	for _, s := range launcher.Status() {
+		assert.Equal(t, StatePending, s.State, s.Name)
+		assert.True(t, s.StartedAt.IsZero())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- launcher.startServices(ctx) }()
+
+	require.Eventually(t, func() bool {
+		return statusOf(launcher, "consumer").State == StateRestarting &&
+			statusOf(launcher, "job").State == StateRunning
+	}, 2*time.Second, 5*time.Millisecond)
+
+	consumer := statusOf(launcher, "consumer")
+	assert.Equal(t, 1, consumer.Restarts)
+	assert.Equal(t, "broker unavailable", consumer.LastError)
+	assert.False(t, consumer.StartedAt.IsZero())
+
+	job := statusOf(launcher, "job")
+	assert.Equal(t, 1, job.Restarts)
+	assert.Equal(t, "bad input", job.LastError)
+	assert.Equal(t, StateRunning, statusOf(launcher, "worker").State)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Equal(t, StateStopped, statusOf(launcher, "worker").State)
+	assert.Equal(t, StateStopped, statusOf(launcher, "job").State)
+}
+
+// TestStatus_Failed tests the state of a service that exits with an error.
+// This verifies the failed service is reported with its error.
+func TestStatus_Failed(t *testing.T) {
+	launcher := NewLauncher()
+	svc := newMockService("worker")
+	svc.startError = errors.New("port in use")
+	require.NoError(t, launcher.AddNamedService("worker", svc))
+
+	require.Error(t, launcher.startServices(context.Background()))
+
+	status := statusOf(launcher, "worker")
+	assert.Equal(t, StateFailed, status.State)
+	assert.Equal(t, "port in use", status.LastError)
+}
+
+// TestStatusHandler tests the HTTP status report.
+// This verifies the report lists every service and rejects other methods.
+func TestStatusHandler(t *testing.T) {
+	launcher := NewLauncher()
+	require.NoError(t, launcher.AddNamedService("worker", newMockService("worker")))
+	handler := launcher.StatusHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/statusz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var body struct {
+		Services []map[string]interface{} `json:"services"`
+		Draining bool                     `json:"draining"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Services, 1)
+	assert.Equal(t, "worker", body.Services[0]["name"])
+	assert.Equal(t, "pending", body.Services[0]["state"])
+	assert.NotContains(t, body.Services[0], "started_at")
+	assert.NotContains(t, body.Services[0], "last_error")
+	assert.False(t, body.Draining)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/statusz", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}